@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/Git-Gopher/go-gopher/assess/markers/analysis"
+	"github.com/Git-Gopher/go-gopher/detector"
 )
 
 type Candidate struct {
@@ -21,6 +22,8 @@ type Grade struct {
 }
 
 func RunMarker(m analysis.MarkerCtx, markers []*analysis.Analyzer) []Candidate {
+	defer detector.ReleaseCommon(m.Model)
+
 	candiateMap := make(map[string]*Candidate)
 	contributionMap := make(map[string]int)
 
@@ -42,6 +42,11 @@ func NewContribution(enriched enriched.EnrichedModel) *contribution {
 	}
 
 	for _, commit := range enriched.Commits {
+		// Formatting commits, eg: mass line ending conversions, are not a contribution.
+		if commit.ChangeKind().IsFormatting() {
+			continue
+		}
+
 		email := commit.Author.Email
 		if _, ok := c.CommitCountMap[email]; !ok {
 			c.CommitCountMap[email] = 0
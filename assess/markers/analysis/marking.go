@@ -4,9 +4,10 @@ import (
 	"time"
 
 	"github.com/Git-Gopher/go-gopher/assess/options"
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/detector"
+	"github.com/Git-Gopher/go-gopher/identity"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
-	"github.com/Git-Gopher/go-gopher/utils"
 	"github.com/Git-Gopher/go-gopher/violation"
 )
 
@@ -25,11 +26,12 @@ type Grade struct {
 type MarkerCtx struct {
 	Model          *enriched.EnrichedModel
 	Contribution   *contribution
-	Author         utils.Authors
+	Author         identity.Resolver
 	LoginWhiteList []string
 	Upis           map[string]string
 	Fullnames      map[string]string
 	CutoffDate     time.Time
+	Parameters     config.Parameters // Parameters of the detectors, from the loaded config.
 }
 
 type MarkerRun func(MarkerCtx) (string, []Mark)
@@ -51,20 +53,12 @@ func DetectorMarker(
 
 		_, _, _, violations := d.Result()
 
-		violations = violation.FilterByLogin(violations, nil, m.LoginWhiteList)
+		violations = violation.FilterByLogin(violations, m.Author, m.LoginWhiteList)
 		violations = violation.FilterByDate(violations, m.CutoffDate)
 
 		for _, v := range violations {
-			login := ""
-			if l, err := v.Login(); err == nil {
-				login = l
-			} else if email, err := v.Email(); err == nil {
-				l, err := m.Author.FindUserName(email)
-				if err != nil {
-					continue
-				}
-				login = *l
-			} else {
+			login, err := violation.ResolveLogin(v, m.Author)
+			if err != nil {
 				continue
 			}
 
@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/assess/options"
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/detector"
+	"github.com/Git-Gopher/go-gopher/identity"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/violation"
+)
+
+func TestDetectorMarkerKeepsBackdatedCommits(t *testing.T) {
+	now := time.Now()
+	daysAgo := func(days int) time.Time {
+		return now.Add(-time.Duration(days) * 24 * time.Hour)
+	}
+	commit := func(hash byte, message string, author, committer time.Time, parents ...byte) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}, Message: message}
+		c.Author.Email, c.Committer.Email = "student@example.com", "student@example.com"
+		c.Author.When, c.Committer.When = author, committer
+		for _, p := range parents {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{p})
+		}
+
+		return c
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			// Finished after the cutoff, backdated with "git commit --date".
+			commit(2, "wip", daysAgo(20), daysAgo(2), 1),
+			commit(1, "Add the parser for the configuration file", daysAgo(30), daysAgo(30)),
+		},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	author := identity.NewResolver()
+	if err := author.Add("student", "student@example.com", identity.Manual); err != nil {
+		t.Fatal(err)
+	}
+
+	m := MarkerCtx{
+		Model:        em,
+		Contribution: NewContribution(*em),
+		Author:       author,
+		CutoffDate:   daysAgo(10),
+	}
+	params := config.DefaultParameters()
+	marks := DetectorMarker(m,
+		[]detector.Detector{
+			detector.NewCommitDetector(detector.ShortCommitMessageDetect(params.ShortCommitMessageDetect)),
+			detector.NewCommitTimestampDetector("CommitTimestampDetector", params.CommitTimestampDetector, m.CutoffDate),
+		},
+		m.Contribution.CommitCountMap,
+		1, options.BasicGradingAlgorithm)
+
+	// The short message was committed after the cutoff and is dropped, the backdated commit is not.
+	if len(marks) != 1 || len(marks[0].Violations) != 1 {
+		t.Fatalf("DetectorMarker() = %v, want one mark with one violation", marks)
+	}
+
+	if _, ok := marks[0].Violations[0].(*violation.CommitTimestampViolation); !ok {
+		t.Errorf("Violations[0] = %T, want *violation.CommitTimestampViolation", marks[0].Violations[0])
+	}
+}
@@ -13,8 +13,10 @@ func NewBranching(settings *options.BranchingSettings) *analysis.Analyzer {
 		branchingName,
 		"Branching marker",
 		func(m analysis.MarkerCtx) (string, []analysis.Mark) {
-			stale := detector.NewBranchDetector(detector.StaleBranchDetect())
-			consistent := detector.NewBranchCompareDetector(detector.BranchNameConsistencyDetect())
+			stale := detector.NewBranchDetector(detector.StaleBranchDetect(m.Parameters.StaleBranchDetect))
+			consistent := detector.NewBranchCompareDetector(
+				detector.BranchNameConsistencyDetect(m.Parameters.BranchNameConsistencyDetect),
+			)
 			feature := detector.NewFeatureBranchDetector("FeatureBranchDetector")
 
 			g := options.GetGradingAlgorithm(settings.GradingAlgorithm, settings.ThresholdSettings)
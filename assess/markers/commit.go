@@ -13,15 +13,20 @@ func NewCommit(settings *options.CommitSettings) *analysis.Analyzer {
 		commitName,
 		"Commit marker",
 		func(m analysis.MarkerCtx) (string, []analysis.Mark) {
+			params := m.Parameters
 			atomicity := detector.NewCommitDistanceDetector(detector.DiffDistanceCalculation())
-			binaries := detector.NewCommitDetector(detector.BinaryDetect())
+			binaries := detector.NewCommitDetector(detector.BinaryDetect(params.BinaryDetect))
 			empty := detector.NewCommitDetector(detector.EmptyCommitDetect())
+			// Commits backdated to beat the cutoff are kept by the date filter.
+			timestamps := detector.NewCommitTimestampDetector(
+				"CommitTimestampDetector", params.CommitTimestampDetector, m.CutoffDate,
+			)
 
 			g := options.GetGradingAlgorithm(settings.GradingAlgorithm, settings.ThresholdSettings)
 
 			return "Commit", analysis.DetectorMarker(
 				m,
-				[]detector.Detector{atomicity, binaries, empty},
+				[]detector.Detector{atomicity, binaries, empty, timestamps},
 				m.Contribution.CommitCountMap,
 				3, g)
 		},
@@ -14,7 +14,7 @@ func NewCommitMessage(settings *options.CommitMessageSetting) *analysis.Analyzer
 		"Commit Message marker",
 		func(m analysis.MarkerCtx) (string, []analysis.Mark) {
 			diff := detector.NewCommitDetector(detector.DiffMatchesMessageDetect())
-			short := detector.NewCommitDetector(detector.ShortCommitMessageDetect())
+			short := detector.NewCommitDetector(detector.ShortCommitMessageDetect(m.Parameters.ShortCommitMessageDetect))
 
 			g := options.GetGradingAlgorithm(settings.GradingAlgorithm, settings.ThresholdSettings)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/Git-Gopher/go-gopher/cache"
 	"github.com/Git-Gopher/go-gopher/discord"
 	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
@@ -45,47 +46,86 @@ func main() {
 			Name:    "action",
 			Aliases: []string{"a"},
 			Usage:   "detect a workflow for current root",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "model",
+					Usage:    "analyze an exported enriched model instead of the current root",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     "export",
+					Usage:    "export the enriched model to the directory",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     "cleanup-script",
+					Usage:    "write a script deleting merged branches to the file, dry run unless run with --execute",
+					Required: false,
+				},
+			},
 			Action: func(ctx *cli.Context) error {
-				utils.Environment(".env")
-				workspace := utils.EnvGithubWorkspace()
+				cfg := utils.ReadConfig(ctx)
 
-				// repository := os.Getenv("GITHUB_REPOSITORY")
-				// sha := os.Getenv("GITHUB_SHA") // commit sha triggered
-				// ref := os.Getenv("GITHUB_REF") // branch ref triggered
+				var enrichedModel *enriched.EnrichedModel
+				var err error
+				if ctx.String("model") != "" {
+					enrichedModel, err = model.LoadEnrichedModel(ctx.String("model"), cfg.Branches)
+					if err != nil {
+						log.Fatalf("Could not load enriched model: %v\n", err)
+					}
+				} else {
+					utils.Environment(".env")
+					workspace := utils.EnvGithubWorkspace()
 
-				// Repo
-				repo, err := git.PlainOpen(workspace)
-				if err != nil {
-					log.Fatalf("cannot read repo: %v\n", err)
-				}
+					// repository := os.Getenv("GITHUB_REPOSITORY")
+					// sha := os.Getenv("GITHUB_SHA") // commit sha triggered
+					// ref := os.Getenv("GITHUB_REF") // branch ref triggered
 
-				gitModel, err := local.NewGitModel(repo)
-				if err != nil {
-					log.Fatalf("Could not create GitModel: %v\n", err)
-				}
+					// Repo
+					repo, err := git.PlainOpen(workspace)
+					if err != nil {
+						log.Fatalf("cannot read repo: %v\n", err)
+					}
 
-				url := os.Getenv("GITHUB_URL")
-				if url == "" {
-					url, err = utils.Url(repo)
+					gitModel, err := local.NewGitModel(repo)
 					if err != nil {
-						log.Fatalf("Could get url from repository: %v\n", err)
+						log.Fatalf("Could not create GitModel: %v\n", err)
 					}
-					log.Printf("GITHUB_URL is not set, falling back to \"%s\"...\n", url)
-				}
-				owner, name, err := utils.OwnerNameFromUrl(url)
-				if err != nil {
-					log.Fatalf("Could not get owner and name from URL: %v\n", err)
-				}
 
-				remoteModel, err := remote.ScrapeRemoteModel(owner, name)
-				if err != nil {
-					log.Fatalf("Could not create RemoteModel: %v\n", err)
+					url := os.Getenv("GITHUB_URL")
+					if url == "" {
+						url, err = utils.Url(repo)
+						if err != nil {
+							log.Fatalf("Could get url from repository: %v\n", err)
+						}
+						log.Printf("GITHUB_URL is not set, falling back to \"%s\"...\n", url)
+					}
+					owner, name, err := utils.OwnerNameFromUrl(url)
+					if err != nil {
+						log.Fatalf("Could not get owner and name from URL: %v\n", err)
+					}
+
+					remoteModel, err := remote.ScrapeRemoteModel(owner, name)
+					if err != nil {
+						log.Fatalf("Could not create RemoteModel: %v\n", err)
+					}
+
+					enrichedModel = enriched.NewEnrichedModel(*gitModel, *remoteModel)
+					if err = enrichedModel.AssignBranchRoles(cfg.Branches); err != nil {
+						log.Fatalf("Could not assign branch roles: %v\n", err)
+					}
 				}
 
-				enrichedModel := enriched.NewEnrichedModel(*gitModel, *remoteModel)
+				if ctx.String("export") != "" {
+					fn, err := enrichedModel.ExportFile(ctx.String("export"))
+					if err != nil {
+						log.Fatalf("Could not export enriched model: %v\n", err)
+					}
+					log.Printf("Exported enriched model to %s", fn)
+				}
 
 				// Authors
-				authors := enriched.PopulateAuthors(enrichedModel)
+				authors := enriched.ResolveIdentities(enrichedModel)
 
 				// Cache
 				current := cache.NewCache(enrichedModel)
@@ -100,7 +140,6 @@ func main() {
 					log.Fatalf("Failed to load caches: %v", err)
 				}
 
-				cfg := utils.ReadConfig(ctx)
 				ghwf := workflow.GithubFlowWorkflow(cfg)
 				violated, count, total, violations, err := ghwf.Analyze(enrichedModel, current, caches)
 				if err != nil {
@@ -108,6 +147,16 @@ func main() {
 				}
 
 				workflow.PrintSummary(authors, violated, count, total, violations)
+				workflow.PrintMergeStrategies(enrichedModel)
+				workflow.PrintMergeDirections(enrichedModel)
+				workflow.PrintReverts(enrichedModel)
+				workflow.PrintBranchRoles(enrichedModel)
+
+				if ctx.String("cleanup-script") != "" {
+					if err = workflow.WriteCleanupScript(ctx.String("cleanup-script"), enrichedModel); err != nil {
+						log.Fatalf("Could not write cleanup script: %v", err)
+					}
+				}
 
 				// Set action outputs to a markdown summary.
 				summary := workflow.MarkdownSummary(authors, violations)
@@ -441,6 +490,16 @@ func main() {
 					Usage:    "csv summary of the workflow run",
 					Required: false,
 				},
+				&cli.StringFlag{
+					Name:     "export",
+					Usage:    "export the enriched models to the directory",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     "cleanup-script",
+					Usage:    "write a script deleting merged branches to the file, dry run unless run with --execute",
+					Required: false,
+				},
 			},
 
 			Subcommands: []*cli.Command{
@@ -482,10 +541,23 @@ func main() {
 							log.Fatalf("Could not scrape GithubModel: %v\n", err)
 						}
 
+						cfg := utils.ReadConfig(ctx)
+
 						enrichedModel := enriched.NewEnrichedModel(*gitModel, *githubModel)
+						if err = enrichedModel.AssignBranchRoles(cfg.Branches); err != nil {
+							log.Fatalf("Could not assign branch roles: %v\n", err)
+						}
+
+						if ctx.String("export") != "" {
+							fn, err := enrichedModel.ExportFile(ctx.String("export"))
+							if err != nil {
+								log.Fatalf("Could not export enriched model: %v\n", err)
+							}
+							log.Printf("Exported enriched model to %s", fn)
+						}
 
 						// Authors
-						authors := enriched.PopulateAuthors(enrichedModel)
+						authors := enriched.ResolveIdentities(enrichedModel)
 
 						// Cache
 						current := cache.NewCache(enrichedModel)
@@ -502,7 +574,6 @@ func main() {
 							log.Fatalf("Failed to load caches: %v", err)
 						}
 
-						cfg := utils.ReadConfig(ctx)
 						ghwf := workflow.GithubFlowWorkflow(cfg)
 						violated, count, total, violations, err := ghwf.Analyze(enrichedModel, current, caches)
 						if err != nil {
@@ -510,6 +581,16 @@ func main() {
 						}
 
 						workflow.PrintSummary(authors, violated, count, total, violations)
+						workflow.PrintMergeStrategies(enrichedModel)
+						workflow.PrintMergeDirections(enrichedModel)
+						workflow.PrintReverts(enrichedModel)
+						workflow.PrintBranchRoles(enrichedModel)
+
+						if ctx.String("cleanup-script") != "" {
+							if err = workflow.WriteCleanupScript(ctx.String("cleanup-script"), enrichedModel); err != nil {
+								log.Fatalf("Could not write cleanup script: %v", err)
+							}
+						}
 
 						if ctx.Bool("csv") {
 							err = ghwf.Csv(workflow.DefaultCsvPath, enrichedModel.Name, enrichedModel.URL)
@@ -550,6 +631,10 @@ func main() {
 						},
 					},
 					Action: func(ctx *cli.Context) error {
+						if ctx.String("cleanup-script") != "" {
+							log.Fatal("--cleanup-script only supports a single repository, use analyze url")
+						}
+
 						if !ctx.Bool("offline") {
 							utils.Environment(".env")
 						}
@@ -611,9 +696,20 @@ func main() {
 							}
 
 							enrichedModel := enriched.NewEnrichedModel(*gitModel, *githubModel)
+							if err = enrichedModel.AssignBranchRoles(cfg.Branches); err != nil {
+								log.Fatalf("Could not assign branch roles: %v\n", err)
+							}
+
+							if ctx.String("export") != "" {
+								fn, err := enrichedModel.ExportFile(ctx.String("export"))
+								if err != nil {
+									log.Fatalf("Could not export enriched model: %v\n", err)
+								}
+								log.Printf("Exported enriched model to %s", fn)
+							}
 
 							// Authors
-							authors := enriched.PopulateAuthors(enrichedModel)
+							authors := enriched.ResolveIdentities(enrichedModel)
 
 							log.Printf("analyzing %s...", p)
 							v, c, t, vs, err := ghwf.Analyze(enrichedModel, nil, nil)
@@ -647,6 +743,61 @@ func main() {
 							}
 						}
 
+						return nil
+					},
+				},
+				{
+					Name:      "model",
+					Aliases:   []string{"m"},
+					Usage:     "analyze exported enriched models without cloning or scraping",
+					ArgsUsage: "<archive>...",
+					Action: func(ctx *cli.Context) error {
+						if ctx.NArg() == 0 {
+							log.Fatal("No enriched model archive provided")
+						}
+
+						if ctx.String("cleanup-script") != "" {
+							log.Fatal("--cleanup-script needs the live repository, use analyze url")
+						}
+
+						cfg := utils.ReadConfig(ctx)
+						ghwf := workflow.GithubFlowWorkflow(cfg)
+
+						for _, p := range ctx.Args().Slice() {
+							enrichedModel, err := model.LoadEnrichedModel(p, cfg.Branches)
+							if err != nil {
+								log.Fatalf("Could not load enriched model: %v\n", err)
+							}
+
+							// Authors
+							authors := enriched.ResolveIdentities(enrichedModel)
+
+							log.Printf("analyzing %s...", p)
+							violated, count, total, violations, err := ghwf.Analyze(enrichedModel, nil, nil)
+							if err != nil {
+								log.Fatalf("Failed to analyze: %v\n", err)
+							}
+
+							workflow.PrintSummary(authors, violated, count, total, violations)
+							workflow.PrintMergeStrategies(enrichedModel)
+							workflow.PrintMergeDirections(enrichedModel)
+							workflow.PrintReverts(enrichedModel)
+							workflow.PrintBranchRoles(enrichedModel)
+
+							if ctx.Bool("csv") {
+								err = ghwf.Csv(workflow.DefaultCsvPath, enrichedModel.Name, enrichedModel.URL)
+								if err != nil {
+									log.Fatalf("Could not create csv summary: %v", err)
+								}
+							}
+
+							if ctx.Bool("logging") {
+								if _, err = ghwf.WriteLog(*enrichedModel, cfg); err != nil {
+									log.Fatalf("Could not write json log: %v", err)
+								}
+							}
+						}
+
 						return nil
 					},
 				},
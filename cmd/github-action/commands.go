@@ -51,17 +51,35 @@ func actionCommand(cCtx *cli.Context) error {
 		return fmt.Errorf("%w: %s != %s", errOwnerMismatch, repoOwner, config.GithubRepositoryOwner)
 	}
 
-	// Create enrichedModel.
-	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName)
-	if err != nil {
-		return fmt.Errorf("failed to create enriched model: %w", err)
+	cfg := readConfig(cCtx)
+
+	// Create enrichedModel, or reload an exported one to reproduce a previous run.
+	var enrichedModel *enriched.EnrichedModel
+	if cCtx.String("model") != "" {
+		enrichedModel, err = model.LoadEnrichedModel(cCtx.String("model"), cfg.Branches)
+		if err != nil {
+			return fmt.Errorf("failed to load enriched model: %w", err)
+		}
+	} else {
+		enrichedModel, err = model.FetchEnrichedModel(repo, repoOwner, repoName, cfg.Branches)
+		if err != nil {
+			return fmt.Errorf("failed to create enriched model: %w", err)
+		}
+	}
+
+	if cCtx.String("export") != "" {
+		fn, err := enrichedModel.ExportFile(cCtx.String("export"))
+		if err != nil {
+			return fmt.Errorf("failed to export enriched model: %w", err)
+		}
+		log.Printf("Exported enriched model to %s", fn)
 	}
 
 	// Create cache.
 	current := cache.NewCache(enrichedModel)
 
 	// Populate authors from enrichedModel.
-	authors := enriched.PopulateAuthors(enrichedModel)
+	authors := enriched.ResolveIdentities(enrichedModel)
 
 	// Read cache.
 	caches, err := cache.Read()
@@ -75,7 +93,6 @@ func actionCommand(cCtx *cli.Context) error {
 			return fmt.Errorf("failed to write cache: %w", err)
 		}
 	}
-	cfg := readConfig(cCtx)
 	ghwf := workflow.GithubFlowWorkflow(cfg)
 	violated, count, total, violations, err := ghwf.Analyze(enrichedModel, current, caches)
 	if err != nil {
@@ -88,6 +105,10 @@ func actionCommand(cCtx *cli.Context) error {
 	}
 
 	workflow.PrintSummary(authors, violated, count, total, violations)
+	workflow.PrintMergeStrategies(enrichedModel)
+	workflow.PrintMergeDirections(enrichedModel)
+	workflow.PrintReverts(enrichedModel)
+	workflow.PrintBranchRoles(enrichedModel)
 
 	if !cCtx.Bool("disable-pr-comment") {
 		summary := workflow.MarkdownSummary(authors, violations)
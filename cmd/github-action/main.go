@@ -21,6 +21,16 @@ func main() {
 			Required: false,
 			Usage:    "disable pr comment output which cleans up stdout log",
 		},
+		&cli.StringFlag{
+			Name:     "model",
+			Required: false,
+			Usage:    "analyze an exported enriched model instead of the workspace",
+		},
+		&cli.StringFlag{
+			Name:     "export",
+			Required: false,
+			Usage:    "export the enriched model to the directory",
+		},
 	}
 	app.Action = actionCommand
 
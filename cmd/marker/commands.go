@@ -15,6 +15,8 @@ import (
 	"github.com/Git-Gopher/go-gopher/assess"
 	"github.com/Git-Gopher/go-gopher/assess/markers/analysis"
 	"github.com/Git-Gopher/go-gopher/assess/options"
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/identity"
 	"github.com/Git-Gopher/go-gopher/model"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/utils"
@@ -28,6 +30,7 @@ import (
 var (
 	errGitHubURL = fmt.Errorf("missing GitHub URL")
 	errLocalDir  = fmt.Errorf("missing Local Directory")
+	errArchive   = fmt.Errorf("missing enriched model archive")
 )
 
 var _ Commands = &Cmds{}
@@ -36,6 +39,7 @@ type Commands interface {
 	SingleUrlCommand(cCtx *cli.Context, flags *Flags) error
 	SingleLocalCommand(cCtx *cli.Context, flags *Flags) error
 	FolderLocalCommand(cCtx *cli.Context, flags *Flags) error
+	ModelCommand(cCtx *cli.Context, flags *Flags) error
 	GenerateConfigCommand(cCtx *cli.Context, flags *Flags) error
 }
 
@@ -64,7 +68,7 @@ func (c *Cmds) SingleUrlCommand(cCtx *cli.Context, flags *Flags) error {
 		return fmt.Errorf("failed to clone repository: %w", err)
 	}
 
-	if err = c.runMarker(repo, githubURL, flags.LookupPath); err != nil {
+	if err = c.runMarker(repo, githubURL, flags.LookupPath, flags.ExportDir, flags.Config); err != nil {
 		return err
 	}
 
@@ -77,10 +81,15 @@ func (c *Cmds) SingleLocalCommand(cCtx *cli.Context, flags *Flags) error {
 		return errLocalDir
 	}
 
-	return c.runLocalRepository(directory, flags.LookupPath)
+	return c.runLocalRepository(directory, flags.LookupPath, flags.ExportDir, flags.Config)
 }
 
-func (c *Cmds) runLocalRepository(directory string, lookupPath string) error {
+func (c *Cmds) runLocalRepository(
+	directory string,
+	lookupPath string,
+	exportDir string,
+	cfg *config.Config,
+) error {
 	// Open repository locally.
 	repo, err := git.PlainOpen(directory)
 	if err != nil {
@@ -92,7 +101,7 @@ func (c *Cmds) runLocalRepository(directory string, lookupPath string) error {
 		return fmt.Errorf("failed to get url: %w", err)
 	}
 
-	if err = c.runMarker(repo, githubURL, lookupPath); err != nil {
+	if err = c.runMarker(repo, githubURL, lookupPath, exportDir, cfg); err != nil {
 		return err
 	}
 
@@ -139,7 +148,7 @@ func (c *Cmds) FolderLocalCommand(cCtx *cli.Context, flags *Flags) error {
 		go func() {
 			select {
 			case repo := <-repoChan:
-				if err := c.runLocalRepository(repo, flags.LookupPath); err != nil {
+				if err := c.runLocalRepository(repo, flags.LookupPath, flags.ExportDir, flags.Config); err != nil {
 					log.Errorf("failed to run local repository: %v", err)
 				}
 				wg.Done()
@@ -157,6 +166,25 @@ func (c *Cmds) FolderLocalCommand(cCtx *cli.Context, flags *Flags) error {
 	return nil
 }
 
+func (c *Cmds) ModelCommand(cCtx *cli.Context, flags *Flags) error {
+	if cCtx.NArg() == 0 {
+		return errArchive
+	}
+
+	for _, path := range cCtx.Args().Slice() {
+		enrichedModel, err := model.LoadEnrichedModel(path, flags.Config.Branches)
+		if err != nil {
+			return fmt.Errorf("failed to load enriched model: %w", err)
+		}
+
+		if err = c.markModel(enrichedModel, enrichedModel.Name, flags.LookupPath, flags.Config.Parameters); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *Cmds) GenerateConfigCommand(cCtx *cli.Context, flags *Flags) error {
 	r := options.NewFileReader(log.StandardLogger(), nil)
 	if err := r.GenerateDefault(flags.OptionsDir); err != nil {
@@ -174,7 +202,13 @@ func (c *Cmds) GenerateConfigCommand(cCtx *cli.Context, flags *Flags) error {
 	return nil
 }
 
-func (c *Cmds) runMarker(repo *git.Repository, githubURL string, lookupPath string) error {
+func (c *Cmds) runMarker(
+	repo *git.Repository,
+	githubURL string,
+	lookupPath string,
+	exportDir string,
+	cfg *config.Config,
+) error {
 	// Get the repositoryName.
 	repoOwner, repoName, err := utils.OwnerNameFromUrl(githubURL)
 	if err != nil {
@@ -182,16 +216,40 @@ func (c *Cmds) runMarker(repo *git.Repository, githubURL string, lookupPath stri
 	}
 
 	// Create enrichedModel.
-	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName)
+	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName, cfg.Branches)
 	if err != nil {
 		return fmt.Errorf("failed to create enriched model: %w", err)
 	}
 
-	// Populate authors from enrichedModel.
-	authors := enriched.PopulateAuthors(enrichedModel)
+	if exportDir != "" {
+		fn, err := enrichedModel.ExportFile(exportDir)
+		if err != nil {
+			return fmt.Errorf("failed to export enriched model: %w", err)
+		}
+		log.Infof("Exported enriched model to %s", fn)
+	}
+
+	return c.markModel(enrichedModel, repoName, lookupPath, cfg.Parameters)
+}
 
+// markModel grades the contributors of the enriched model and writes their reports.
+func (c *Cmds) markModel(
+	enrichedModel *enriched.EnrichedModel,
+	repoName string,
+	lookupPath string,
+	params config.Parameters,
+) error {
 	// Fetch lookup.
 	upis, fullnames := fetchLookup(lookupPath)
+
+	// Lookup names help resolve local signatures without a GitHub login.
+	mappings := make([]identity.Mapping, 0, len(fullnames))
+	for login, fullname := range fullnames {
+		mappings = append(mappings, identity.Mapping{Login: login, Name: fullname, Source: identity.Lookup})
+	}
+
+	// Resolve identities from enrichedModel.
+	authors := enriched.ResolveIdentities(enrichedModel, mappings...)
 	// Read marker configs
 	o := LoadOptions(log.StandardLogger())
 	analyzers := assess.LoadAnalyzer(o)
@@ -207,6 +265,7 @@ func (c *Cmds) runMarker(repo *git.Repository, githubURL string, lookupPath stri
 			Contribution: analysis.NewContribution(*enrichedModel),
 			Author:       authors,
 			CutoffDate:   cutoff,
+			Parameters:   params,
 		},
 		analyzers,
 	)
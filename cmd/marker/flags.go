@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/utils"
 	"github.com/Git-Gopher/go-gopher/version"
 	log "github.com/sirupsen/logrus"
@@ -22,6 +23,8 @@ type Flags struct {
 	OptionsDir  string
 	EnvDir      string
 	LookupPath  string
+	ExportDir   string // Directory to export enriched models to, skipped when empty.
+	Config      *config.Config
 }
 
 func NewFlags() *Flags {
@@ -36,6 +39,15 @@ func NewFlags() *Flags {
 type ActionWithFlagFunc func(cCtx *cli.Context, flags *Flags) error
 
 func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
+	return loadFlags(command, true)
+}
+
+// LoadModelFlags loads the flags for commands on exported enriched models, which do not need a GitHub token.
+func LoadModelFlags(command ActionWithFlagFunc) cli.ActionFunc {
+	return loadFlags(command, false)
+}
+
+func loadFlags(command ActionWithFlagFunc, requireToken bool) cli.ActionFunc {
 	return func(cCtx *cli.Context) error {
 		log.Infof("BuildVersion: %v\n", version.BuildVersion())
 
@@ -57,7 +69,7 @@ func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
 			flags.GithubToken = os.Getenv("GITHUB_TOKEN")
 		}
 
-		if flags.GithubToken == "" {
+		if flags.GithubToken == "" && requireToken {
 			return errGitHubToken
 		}
 
@@ -73,6 +85,14 @@ func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
 			flags.LookupPath = cCtx.String("lookup-path")
 		}
 
+		flags.ExportDir = cCtx.String("export")
+
+		cfg, err := utils.LoadConfig(cCtx.String("config"))
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		flags.Config = cfg
+
 		return command(cCtx, flags)
 	}
 }
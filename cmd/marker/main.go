@@ -51,6 +51,15 @@ func main() {
 			ArgsUsage: "<folder>",
 			Action:    LoadFlags(cmd.FolderLocalCommand),
 		},
+		{
+			Name:      "model",
+			Aliases:   []string{"m"},
+			Category:  "Marker",
+			Usage:     "grade exported enriched models",
+			UsageText: "go-gopher-marker model <archive>... - grade enriched models exported with --export",
+			ArgsUsage: "<archive>...",
+			Action:    LoadModelFlags(cmd.ModelCommand),
+		},
 		{
 			Name:     "generate",
 			Category: "Utils",
@@ -81,6 +90,15 @@ func main() {
 			DefaultText: "./data/se206-2022-beta-students.csv",
 			Usage:       "student lookup csv file location. Default: ./data/se206-2022-beta-students.csv",
 		},
+		&cli.StringFlag{
+			Name:  "export",
+			Usage: "export the enriched models to the directory for later grading",
+		},
+		&cli.StringFlag{
+			Name:    "config",
+			Aliases: []string{"c"},
+			Usage:   "configuration file with the branch roles, the default configuration when empty",
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
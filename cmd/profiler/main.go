@@ -11,6 +11,7 @@ import (
 
 	"github.com/Git-Gopher/go-gopher/assess"
 	"github.com/Git-Gopher/go-gopher/assess/markers/analysis"
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/utils"
@@ -30,6 +31,9 @@ const repoName = "assignment-3-and-project-team-01"
 var (
 	cpuprofile = flag.String("cpuprofile", fmt.Sprintf("prof/%s-cpu.prof", repoName), "write cpu profile to `file`")
 	memprofile = flag.String("memprofile", fmt.Sprintf("prof/%s-mem.prof", repoName), "write memory profile to `file`")
+	modelPath  = flag.String("model", "", "profile an exported enriched model `file` instead of fetching")
+	exportDir  = flag.String("export", "", "export the enriched model to `directory`")
+	configPath = flag.String("config", "", "read the branch roles from the config `file` instead of the default")
 )
 
 func main() {
@@ -56,11 +60,18 @@ func main() {
 		PadLevelText: true,
 	})
 
-	err := fetch("https://github.com/GitWorkflowPractice/" + repoName)
+	cfg, err := utils.LoadConfig(*configPath)
+	if err != nil {
+		log.Panicf("failed to load config: %s", err)
+	}
+
+	enrichedModel, err := load("https://github.com/GitWorkflowPractice/"+repoName, cfg.Branches)
 	if err != nil {
 		log.Panicf("failed to fetch: %s", err)
 	}
 
+	mark(enrichedModel, cfg.Parameters)
+
 	if *memprofile != "" {
 		f, err := os.Create(*memprofile)
 		if err != nil {
@@ -78,7 +89,34 @@ func main() {
 	}
 }
 
-func fetch(githubURL string) error {
+// load fetches the enriched model, or imports it when an exported model is given.
+func load(githubURL string, roles config.BranchRoles) (*enriched.EnrichedModel, error) {
+	if *modelPath != "" {
+		enrichedModel, err := model.LoadEnrichedModel(*modelPath, roles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load enriched model: %w", err)
+		}
+
+		return enrichedModel, nil
+	}
+
+	enrichedModel, err := fetch(githubURL, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if *exportDir != "" {
+		fn, err := enrichedModel.ExportFile(*exportDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export enriched model: %w", err)
+		}
+		log.Infof("Exported enriched model to %s", fn)
+	}
+
+	return enrichedModel, nil
+}
+
+func fetch(githubURL string, roles config.BranchRoles) (*enriched.EnrichedModel, error) {
 	utils.Environment(".env")
 
 	// Clone repository into memory.
@@ -90,23 +128,27 @@ func fetch(githubURL string) error {
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("failed to clone repository: %w", err)
+		return nil, fmt.Errorf("failed to clone repository: %w", err)
 	}
 
 	// Get the repositoryName.
 	repoOwner, repoName, err := utils.OwnerNameFromUrl(githubURL)
 	if err != nil {
-		return fmt.Errorf("failed to get owner and repo name: %w", err)
+		return nil, fmt.Errorf("failed to get owner and repo name: %w", err)
 	}
 
 	// Create enrichedModel.
-	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName)
+	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName, roles)
 	if err != nil {
-		return fmt.Errorf("failed to create enriched model: %w", err)
+		return nil, fmt.Errorf("failed to create enriched model: %w", err)
 	}
 
+	return enrichedModel, nil
+}
+
+func mark(enrichedModel *enriched.EnrichedModel, params config.Parameters) {
 	// Populate authors from enrichedModel.
-	authors := enriched.PopulateAuthors(enrichedModel)
+	authors := enriched.ResolveIdentities(enrichedModel)
 
 	// Read marker configs
 	o := LoadOptions(log.StandardLogger())
@@ -120,6 +162,7 @@ func fetch(githubURL string) error {
 			Contribution:   analysis.NewContribution(*enrichedModel),
 			Author:         authors,
 			LoginWhiteList: strings.Split(o.LoginWhiteList, ","),
+			Parameters:     params,
 		},
 		analyzers,
 	)
@@ -130,6 +173,4 @@ func fetch(githubURL string) error {
 	for _, candidate := range candidates {
 		log.Printf("#### @%s ####\n", candidate.Username)
 	}
-
-	return nil
 }
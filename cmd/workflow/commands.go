@@ -10,7 +10,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/remote"
 	"github.com/Git-Gopher/go-gopher/utils"
 	"github.com/Git-Gopher/go-gopher/workflow"
@@ -26,6 +28,7 @@ var (
 	errGitHubURL = fmt.Errorf("missing GitHub URL")
 	errLocalDir  = fmt.Errorf("missing Local Directory")
 	errBatchJson = fmt.Errorf("missing repository json file")
+	errArchive   = fmt.Errorf("missing enriched model archive")
 )
 
 type logs struct {
@@ -34,6 +37,7 @@ type logs struct {
 	// Skipped          bool                    `json"skipped"`
 	Scores           map[string]*rule.Scores `json:"scores"`
 	DetectedWorkflow []string                `json:"detectedWorkflow"`
+	BranchRoles      *enriched.BranchRoles   `json:"branchRoles"`
 }
 
 var _ Commands = &Cmds{}
@@ -42,6 +46,7 @@ type Commands interface {
 	SingleUrlCommand(cCtx *cli.Context, flags *Flags) error
 	SingleLocalCommand(cCtx *cli.Context, flags *Flags) error
 	BatchUrlCommand(cCtx *cli.Context, flags *Flags) error
+	ModelCommand(cCtx *cli.Context, flags *Flags) error
 }
 
 type Cmds struct{}
@@ -69,7 +74,7 @@ func (c *Cmds) SingleUrlCommand(cCtx *cli.Context, flags *Flags) error {
 		return fmt.Errorf("failed to clone repository: %w", err)
 	}
 
-	if err = c.runRules(repo, githubURL); err != nil {
+	if err = c.runRules(repo, githubURL, flags.ExportDir, flags.Config.Branches); err != nil {
 		return err
 	}
 
@@ -82,10 +87,10 @@ func (c *Cmds) SingleLocalCommand(cCtx *cli.Context, flags *Flags) error {
 		return errLocalDir
 	}
 
-	return c.runLocalRepository(directory)
+	return c.runLocalRepository(directory, flags.ExportDir, flags.Config.Branches)
 }
 
-func (c *Cmds) runLocalRepository(directory string) error {
+func (c *Cmds) runLocalRepository(directory string, exportDir string, roles config.BranchRoles) error {
 	// Open repository locally.
 	repo, err := git.PlainOpen(directory)
 	if err != nil {
@@ -97,7 +102,7 @@ func (c *Cmds) runLocalRepository(directory string) error {
 		return fmt.Errorf("failed to get url: %w", err)
 	}
 
-	if err = c.runRules(repo, githubURL); err != nil {
+	if err = c.runRules(repo, githubURL, exportDir, roles); err != nil {
 		return err
 	}
 
@@ -170,7 +175,7 @@ func (c *Cmds) BatchUrlCommand(cCtx *cli.Context, flags *Flags) error {
 					}
 
 					log.Infof("Finished repository %s to memory (%s)...", url, time.Since(start))
-					if err = c.runRules(repo, url); err != nil {
+					if err = c.runRules(repo, url, flags.ExportDir, flags.Config.Branches); err != nil {
 						log.Errorf("failed to run rules: %v", err)
 						wg.Done()
 
@@ -205,7 +210,31 @@ func (c *Cmds) BatchUrlCommand(cCtx *cli.Context, flags *Flags) error {
 	return nil
 }
 
-func (c *Cmds) runRules(repo *git.Repository, githubURL string) error {
+func (c *Cmds) ModelCommand(cCtx *cli.Context, flags *Flags) error {
+	if cCtx.NArg() == 0 {
+		return errArchive
+	}
+
+	for _, path := range cCtx.Args().Slice() {
+		enrichedModel, err := model.LoadEnrichedModel(path, flags.Config.Branches)
+		if err != nil {
+			return fmt.Errorf("failed to load enriched model: %w", err)
+		}
+
+		if err = c.detectModel(enrichedModel, enrichedModel.URL, enrichedModel.Owner, enrichedModel.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Cmds) runRules(
+	repo *git.Repository,
+	githubURL string,
+	exportDir string,
+	roles config.BranchRoles,
+) error {
 	// Get the repositoryName.
 	repoOwner, repoName, err := utils.OwnerNameFromUrl(githubURL)
 	if err != nil {
@@ -216,14 +245,27 @@ func (c *Cmds) runRules(repo *git.Repository, githubURL string) error {
 	log.Infof("Fetching enriched model for repository %s/%s...", repoOwner, repoName)
 	start := time.Now()
 
-	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName)
+	enrichedModel, err := model.FetchEnrichedModel(repo, repoOwner, repoName, roles)
 	if err != nil {
 		return fmt.Errorf("failed to create enriched model: %w", err)
 	}
 	log.Infof("Done Fetching enriched model for repository  %s/%s (%s)...", repoOwner, repoName, time.Since(start))
 
+	if exportDir != "" {
+		fn, err := enrichedModel.ExportFile(exportDir)
+		if err != nil {
+			return fmt.Errorf("failed to export enriched model: %w", err)
+		}
+		log.Infof("Exported enriched model to %s", fn)
+	}
+
+	return c.detectModel(enrichedModel, githubURL, repoOwner, repoName)
+}
+
+// detectModel scores the workflow rules on the enriched model and writes the log.
+func (c *Cmds) detectModel(enrichedModel *enriched.EnrichedModel, githubURL, repoOwner, repoName string) error {
 	log.Infof("Running rules for %s/%s", repoOwner, repoName)
-	start = time.Now()
+	start := time.Now()
 
 	scoresMap := workflow.Detect(rule.RuleCtx{
 		Model:          enrichedModel,
@@ -256,7 +298,7 @@ func (c *Cmds) runRules(repo *git.Repository, githubURL string) error {
 		}
 	}
 
-	if err = writeLog(githubURL, scoresMap, detectedWorkflow, repoOwner, repoName); err != nil {
+	if err := writeLog(githubURL, scoresMap, detectedWorkflow, enrichedModel.Roles, repoOwner, repoName); err != nil {
 		return err
 	}
 
@@ -267,6 +309,7 @@ func (c *Cmds) runRules(repo *git.Repository, githubURL string) error {
 func writeLog(githubURL string,
 	scoresMap map[string]*rule.Scores,
 	detectedWorkflow []string,
+	roles *enriched.BranchRoles,
 	repoOwner string,
 	repoName string,
 ) error {
@@ -277,6 +320,7 @@ func writeLog(githubURL string,
 		Url:              githubURL,
 		DetectedWorkflow: detectedWorkflow,
 		Scores:           scoresMap,
+		BranchRoles:      roles,
 		// Skipped:          false,
 	}
 
@@ -2,8 +2,10 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"os"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/utils"
 	"github.com/Git-Gopher/go-gopher/version"
 	log "github.com/sirupsen/logrus"
@@ -19,6 +21,8 @@ type Flags struct {
 	GithubToken string
 	EnvDir      string
 	Timeout     int
+	ExportDir   string // Directory to export enriched models to, skipped when empty.
+	Config      *config.Config
 }
 
 func NewFlags() *Flags {
@@ -32,6 +36,15 @@ func NewFlags() *Flags {
 type ActionWithFlagFunc func(cCtx *cli.Context, flags *Flags) error
 
 func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
+	return loadFlags(command, true)
+}
+
+// LoadModelFlags loads the flags for commands on exported enriched models, which do not need a GitHub token.
+func LoadModelFlags(command ActionWithFlagFunc) cli.ActionFunc {
+	return loadFlags(command, false)
+}
+
+func loadFlags(command ActionWithFlagFunc, requireToken bool) cli.ActionFunc {
 	return func(cCtx *cli.Context) error {
 		log.Infof("BuildVersion: %v\n", version.BuildVersion())
 
@@ -49,7 +62,7 @@ func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
 			flags.GithubToken = os.Getenv("GITHUB_TOKEN")
 		}
 
-		if flags.GithubToken == "" {
+		if flags.GithubToken == "" && requireToken {
 			return errGitHubToken
 		}
 
@@ -64,6 +77,14 @@ func LoadFlags(command ActionWithFlagFunc) cli.ActionFunc {
 			flags.Timeout = timeout
 		}
 
+		flags.ExportDir = cCtx.String("export")
+
+		cfg, err := utils.LoadConfig(cCtx.String("config"))
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		flags.Config = cfg
+
 		return command(cCtx, flags)
 	}
 }
@@ -43,6 +43,15 @@ func main() {
 			ArgsUsage: "<repos.json>",
 			Action:    LoadFlags(cmd.BatchUrlCommand),
 		},
+		{
+			Name:      "model",
+			Aliases:   []string{"m"},
+			Category:  "Repository",
+			Usage:     "evaluate exported enriched models",
+			UsageText: "go-gopher-workflow model <archive>... - evaluate enriched models exported with --export",
+			ArgsUsage: "<archive>...",
+			Action:    LoadModelFlags(cmd.ModelCommand),
+		},
 	}
 	app.Flags = []cli.Flag{
 		&cli.StringFlag{
@@ -60,6 +69,15 @@ func main() {
 			Name:  "timeout",
 			Usage: "timeout in seconds before the repository is skipped",
 		},
+		&cli.StringFlag{
+			Name:  "export",
+			Usage: "export the enriched models to the directory for later evaluation",
+		},
+		&cli.StringFlag{
+			Name:    "config",
+			Aliases: []string{"c"},
+			Usage:   "configuration file with the branch roles, the default configuration when empty",
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -19,6 +19,33 @@ type Config struct {
 		Enabled bool
 		Weight  int
 	}
+	// Expected pull request merge strategy: any, merge, squash or rebase.
+	MergeStrategy string
+	// How merges of long lived branches into feature branches are reported: allow, suggest or flag.
+	BackMerges string
+	// Roles of long lived branches, empty roles are inferred.
+	Branches BranchRoles
+	// Thresholds of detectors, left out parameters use the defaults.
+	Parameters Parameters
+}
+
+// BranchRoles maps branch names and glob patterns to their role in the workflow.
+type BranchRoles struct {
+	Primary     string   // Branch that is released from, eg: main.
+	Develop     string   // Integration branch when different to primary, eg: develop.
+	Release     []string // Release branch patterns, eg: release/*.
+	Environment []string // Deployment branches, eg: staging, production.
+	Hotfix      []string // Hotfix branch patterns, eg: hotfix/*.
+}
+
+// newConfig has the default parameters to decode over, so parameters that are left out keep their default and
+// can be set to 0.
+func newConfig() Config {
+	t := Config{Parameters: DefaultParameters()}
+	// Decoding merges maps, rules that are set replace the default rules instead.
+	t.Parameters.BranchNamingDetect.Rules = nil
+
+	return t
 }
 
 func Read(path string) (*Config, error) {
@@ -27,21 +54,31 @@ func Read(path string) (*Config, error) {
 		return nil, fmt.Errorf("Failed to read config file: %w", err)
 	}
 
-	t := Config{}
+	t := newConfig()
 
 	if err = json.Unmarshal(data, &t); err != nil {
 		return nil, fmt.Errorf("Failed to parse config file: %w", err)
 	}
 
+	t.Parameters.setDefaults()
+	if err = t.Parameters.Validate(); err != nil {
+		return nil, fmt.Errorf("Failed to validate config file: %w", err)
+	}
+
 	return &t, nil
 }
 
 // Read a default config from embedded default config.
 func Default() (*Config, error) {
-	t := Config{}
+	t := newConfig()
 	if err := json.Unmarshal(defaultConfig, &t); err != nil {
 		return nil, fmt.Errorf("Failed to parse embedded config file: %w", err)
 	}
 
+	t.Parameters.setDefaults()
+	if err := t.Parameters.Validate(); err != nil {
+		return nil, fmt.Errorf("Failed to validate embedded config file: %w", err)
+	}
+
 	return &t, nil
 }
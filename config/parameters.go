@@ -0,0 +1,478 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/lint"
+	"github.com/Git-Gopher/go-gopher/secret"
+)
+
+var ErrInvalidParameter = errors.New("invalid detector parameter")
+
+// Parameters tune the thresholds of detectors, keyed by detector name in the config.
+// Parameters that are left out use the defaults.
+type Parameters struct {
+	StaleBranchDetect              StaleBranchParameters
+	ShortCommitMessageDetect       ShortCommitMessageParameters
+	BranchNameConsistencyDetect    BranchNameConsistencyParameters
+	BinaryDetect                   BinaryParameters
+	ConventionalCommitDetect       ConventionalCommitParameters
+	CommitMessageLintDetect        CommitMessageLintParameters
+	SecretDetector                 SecretParameters
+	LargeFileDetector              LargeFileParameters
+	FixupCommitDetector            FixupCommitParameters
+	BranchDivergenceDetect         BranchDivergenceParameters
+	PullRequestSizeDetector        PullRequestSizeParameters
+	PullRequestRubberStampDetector PullRequestRubberStampParameters
+	CommitTimestampDetector        CommitTimestampParameters
+	BranchNamingDetect             BranchNamingParameters
+	StaleIssueDetector             StaleIssueParameters
+	BuildArtifactDetector          BuildArtifactParameters
+	TestAccompanimentDetector      TestAccompanimentParameters
+}
+
+// StaleBranchParameters for StaleBranchDetect.
+type StaleBranchParameters struct {
+	Days int // Days without commits before a branch is stale.
+}
+
+// ShortCommitMessageParameters for ShortCommitMessageDetect.
+type ShortCommitMessageParameters struct {
+	MinWords   int      // Minimum number of words in a commit message.
+	Exclusions []string // Messages that are allowed to be short, compared case insensitively.
+}
+
+// BranchNameConsistencyParameters for BranchNameConsistencyDetect.
+type BranchNameConsistencyParameters struct {
+	// Fraction of the other branches a branch name must be similar to, between 0 and 1.
+	// Higher values report more branches.
+	SimilarityFactor float64
+}
+
+// BinaryParameters for BinaryDetect.
+type BinaryParameters struct {
+	Extensions []string // Extensions of binary files that should not be committed, eg: .exe.
+}
+
+// ConventionalCommitParameters for ConventionalCommitDetect.
+type ConventionalCommitParameters struct {
+	Types  []string // Allowed commit types, eg: feat, fix.
+	Scopes []string // Allowed scopes, any scope is allowed when empty.
+}
+
+// CommitMessageLintParameters for CommitMessageLintDetect.
+type CommitMessageLintParameters struct {
+	Rules            []string // Enabled rules, eg: subject-length, all rules are enabled when left out.
+	MaxSubjectLength int      // Maximum number of characters in the subject.
+	BodyWrapWidth    int      // Maximum number of characters in a body line.
+	GenericSubjects  []string // Subjects that do not describe a change, compared case insensitively.
+}
+
+// SecretParameters for SecretDetector.
+type SecretParameters struct {
+	// Entropy in bits per character of values assigned to secret sounding names before they are secrets.
+	EntropyThreshold float64
+	Filenames        []string // Sensitive file names and glob patterns, eg: .env, *.pem.
+}
+
+// LargeFileParameters for LargeFileDetector.
+type LargeFileParameters struct {
+	MaxSizeKB int64 // Size in kilobytes before a file is large.
+}
+
+// FixupCommitParameters for FixupCommitDetector.
+type FixupCommitParameters struct {
+	Markers []string // Subject prefixes of commits that should be cleaned before merging, compared case insensitively.
+}
+
+// BranchDivergenceParameters for BranchDivergenceDetect.
+type BranchDivergenceParameters struct {
+	MaxBehind  int // Commits a branch can be behind the branch it merges into.
+	MaxAgeDays int // Days since a branch forked before it is long lived.
+}
+
+// PullRequestSizeParameters for PullRequestSizeDetector.
+type PullRequestSizeParameters struct {
+	MaxLines       int      // Lines added and deleted before a pull request is too large to review.
+	MaxFiles       int      // Files changed before a pull request is too large to review.
+	MaxCommits     int      // Commits before a pull request is too large to review.
+	GeneratedPaths []string // Generated files that are not counted, eg: *.pb.go, directories end with a slash.
+}
+
+// PullRequestRubberStampParameters for PullRequestRubberStampDetector.
+type PullRequestRubberStampParameters struct {
+	MaxLinesPerMinute int // Lines reviewed per minute before an approval is implausibly fast.
+	MinLines          int // Lines changed before the review speed is checked.
+}
+
+// CommitTimestampParameters for CommitTimestampDetector.
+type CommitTimestampParameters struct {
+	MaxClockSkewMinutes int // Minutes a timestamp can be out of order for clock differences between machines.
+	MaxDateGapDays      int // Days between the author and committer dates before a commit was rewritten or backdated.
+}
+
+// BranchNamingParameters for BranchNamingDetect.
+type BranchNamingParameters struct {
+	// Rules are the allowed names of each branch role, eg: "feature" or "hotfix". Names are glob patterns, or
+	// regular expressions when they start with ^. A capture group named issue must be an issue of the repository.
+	// Roles without rules are not checked.
+	Rules map[string][]string
+}
+
+// StaleIssueParameters for StaleIssueDetector.
+type StaleIssueParameters struct {
+	Days int // Days without activity before an open issue is stale.
+}
+
+// BuildArtifactParameters for BuildArtifactDetector.
+type BuildArtifactParameters struct {
+	Packs []string // Names of the ArtifactPacks to check, eg: "node".
+	Paths []string // Additional build artifacts, directories end with a slash.
+}
+
+// TestAccompanimentParameters for TestAccompanimentDetector.
+type TestAccompanimentParameters struct {
+	// Test files, eg: *_test.go. The * is the name of the source file the test belongs to, directories end with
+	// a slash.
+	TestPaths        []string
+	SourceExtensions []string // Extensions of production source files, eg: .go.
+	MinRatio         float64  // Fraction of source changes with test changes before an author or pull request is reported.
+}
+
+// ArtifactPacks are the build artifacts, dependencies and editor files of each ecosystem that should not be
+// committed. Directories end with a slash and match anywhere in the path, other patterns match the file name.
+// Patterns starting with ! are files of the ecosystem that are committed, eg: the Gradle wrapper.
+var ArtifactPacks = map[string][]string{
+	"node":     {"node_modules/", ".npm/", ".next/", ".nuxt/", "npm-debug.log", "yarn-error.log"},
+	"java":     {"target/", ".gradle/", "*.class", "*.jar", "*.war", "!gradle-wrapper.jar"},
+	"rust":     {"target/"},
+	"dotnet":   {"bin/", "obj/", "*.dll", "*.pdb", "*.suo", "*.user"},
+	"python":   {"__pycache__/", ".venv/", "venv/", ".pytest_cache/", ".mypy_cache/", ".tox/", "*.egg-info/", "*.pyc"},
+	"ide":      {".idea/", ".vs/", "*.iml", "*.swp"},
+	"os":       {".DS_Store", "Thumbs.db", "desktop.ini"},
+	"coverage": {"coverage/", ".nyc_output/", "htmlcov/", ".coverage", "coverage.out", "lcov.info", "jacoco.exec"},
+}
+
+// ArtifactManifests are the project files of the ecosystems with generic build directories, eg: bin/ or target/.
+// The directories of these packs only match next to one of the project files, so a target/ directory belongs to
+// either the Maven or the Cargo project next to it, and a bin/ directory of scripts is not build output.
+var ArtifactManifests = map[string][]string{
+	"java":   {"pom.xml", "build.gradle", "build.gradle.kts", "settings.gradle", "settings.gradle.kts"},
+	"rust":   {"Cargo.toml"},
+	"dotnet": {"*.csproj", "*.fsproj", "*.vbproj", "*.sln"},
+}
+
+// branchRoles are the names of the roles branch naming rules can be set for.
+var branchRoles = []string{"feature", "primary", "develop", "release", "environment", "hotfix"}
+
+// DefaultParameters are the parameters used when the config leaves them out.
+func DefaultParameters() Parameters {
+	return Parameters{
+		StaleBranchDetect: StaleBranchParameters{
+			Days: 14,
+		},
+		ShortCommitMessageDetect: ShortCommitMessageParameters{
+			MinWords:   5,
+			Exclusions: []string{"first commit", "initial commit"},
+		},
+		BranchNameConsistencyDetect: BranchNameConsistencyParameters{
+			SimilarityFactor: 0.175,
+		},
+		BinaryDetect: BinaryParameters{
+			Extensions: []string{".exe", ".jar", ".class"},
+		},
+		ConventionalCommitDetect: ConventionalCommitParameters{
+			Types: []string{"feat", "fix", "build", "chore", "ci", "docs", "perf", "refactor", "revert", "style", "test"},
+		},
+		CommitMessageLintDetect: CommitMessageLintParameters{
+			Rules:            lintRules(),
+			MaxSubjectLength: 72,
+			BodyWrapWidth:    72,
+			GenericSubjects:  []string{"fix", "fixes", "update", "updates", "wip", "changes", "misc", "stuff", "commit"},
+		},
+		SecretDetector: SecretParameters{
+			EntropyThreshold: 3.5,
+			Filenames:        append([]string(nil), secret.DefaultFilenames...), // The config is decoded over it.
+		},
+		LargeFileDetector: LargeFileParameters{
+			MaxSizeKB: 1024,
+		},
+		FixupCommitDetector: FixupCommitParameters{
+			Markers: []string{"fixup!", "squash!", "amend!", "wip", "tmp", "temp", "asdf"},
+		},
+		BranchDivergenceDetect: BranchDivergenceParameters{
+			MaxBehind:  50,
+			MaxAgeDays: 30,
+		},
+		PullRequestSizeDetector: PullRequestSizeParameters{
+			MaxLines:   400,
+			MaxFiles:   20,
+			MaxCommits: 20,
+			GeneratedPaths: []string{
+				"vendor/", "node_modules/", "dist/", "*.pb.go", "*_gen.go", "*.gen.go", "zz_generated.*",
+				"*.min.js", "*.min.css", "*.snap", "*.lock", "go.sum", "package-lock.json",
+			},
+		},
+		PullRequestRubberStampDetector: PullRequestRubberStampParameters{
+			MaxLinesPerMinute: 100,
+			MinLines:          100,
+		},
+		CommitTimestampDetector: CommitTimestampParameters{
+			MaxClockSkewMinutes: 60,
+			MaxDateGapDays:      14,
+		},
+		BranchNamingDetect: BranchNamingParameters{
+			Rules: map[string][]string{
+				"feature": {"feature/*", "feat/*", "bugfix/*", "fix/*", "chore/*", "docs/*", "refactor/*", "test/*"},
+				"hotfix":  {"hotfix/*"},
+				"release": {`^release/\d+\.\d+(\.\d+)?$`},
+			},
+		},
+		StaleIssueDetector: StaleIssueParameters{
+			Days: 30,
+		},
+		BuildArtifactDetector: BuildArtifactParameters{
+			Packs: []string{"coverage", "dotnet", "ide", "java", "node", "os", "python", "rust"},
+			Paths: []string{},
+		},
+		TestAccompanimentDetector: TestAccompanimentParameters{
+			TestPaths: []string{
+				"*_test.go", "*Test.java", "*Tests.java", "*Test.kt", "*Tests.cs", "*.spec.ts", "*.test.ts",
+				"*.spec.tsx", "*.test.tsx", "*.spec.js", "*.test.js", "test_*.py", "*_test.py", "*_spec.rb",
+				"__tests__/", "test/", "tests/", "spec/",
+			},
+			SourceExtensions: []string{".go", ".java", ".kt", ".cs", ".ts", ".tsx", ".js", ".jsx", ".py", ".rb", ".rs"},
+			MinRatio:         0.5,
+		},
+	}
+}
+
+// setDefaults fills the lists and rules that are null, or rules that are left out, with the defaults.
+func (p *Parameters) setDefaults() {
+	defaults := DefaultParameters()
+
+	if p.ShortCommitMessageDetect.Exclusions == nil {
+		p.ShortCommitMessageDetect.Exclusions = defaults.ShortCommitMessageDetect.Exclusions
+	}
+
+	if p.BinaryDetect.Extensions == nil {
+		p.BinaryDetect.Extensions = defaults.BinaryDetect.Extensions
+	}
+
+	if len(p.ConventionalCommitDetect.Types) == 0 {
+		p.ConventionalCommitDetect.Types = defaults.ConventionalCommitDetect.Types
+	}
+
+	if p.CommitMessageLintDetect.Rules == nil {
+		p.CommitMessageLintDetect.Rules = defaults.CommitMessageLintDetect.Rules
+	}
+
+	if p.CommitMessageLintDetect.GenericSubjects == nil {
+		p.CommitMessageLintDetect.GenericSubjects = defaults.CommitMessageLintDetect.GenericSubjects
+	}
+
+	if p.SecretDetector.Filenames == nil {
+		p.SecretDetector.Filenames = defaults.SecretDetector.Filenames
+	}
+
+	if p.FixupCommitDetector.Markers == nil {
+		p.FixupCommitDetector.Markers = defaults.FixupCommitDetector.Markers
+	}
+
+	if p.PullRequestSizeDetector.GeneratedPaths == nil {
+		p.PullRequestSizeDetector.GeneratedPaths = defaults.PullRequestSizeDetector.GeneratedPaths
+	}
+
+	if p.BranchNamingDetect.Rules == nil {
+		p.BranchNamingDetect.Rules = defaults.BranchNamingDetect.Rules
+	}
+
+	if p.BuildArtifactDetector.Packs == nil {
+		p.BuildArtifactDetector.Packs = defaults.BuildArtifactDetector.Packs
+	}
+
+	if p.BuildArtifactDetector.Paths == nil {
+		p.BuildArtifactDetector.Paths = defaults.BuildArtifactDetector.Paths
+	}
+
+	if p.TestAccompanimentDetector.TestPaths == nil {
+		p.TestAccompanimentDetector.TestPaths = defaults.TestAccompanimentDetector.TestPaths
+	}
+
+	if p.TestAccompanimentDetector.SourceExtensions == nil {
+		p.TestAccompanimentDetector.SourceExtensions = defaults.TestAccompanimentDetector.SourceExtensions
+	}
+}
+
+// lintRules are the names of all commit message lint rules.
+func lintRules() []string {
+	rules := make([]string, len(lint.Rules))
+	for i, r := range lint.Rules {
+		rules[i] = string(r)
+	}
+
+	return rules
+}
+
+// Validate checks the parameters are within their allowed ranges.
+func (p *Parameters) Validate() error {
+	if p.StaleBranchDetect.Days < 0 {
+		return fmt.Errorf("%w: StaleBranchDetect.days must be positive, got %d",
+			ErrInvalidParameter, p.StaleBranchDetect.Days)
+	}
+
+	if p.ShortCommitMessageDetect.MinWords < 0 {
+		return fmt.Errorf("%w: ShortCommitMessageDetect.minWords must be positive, got %d",
+			ErrInvalidParameter, p.ShortCommitMessageDetect.MinWords)
+	}
+
+	if f := p.BranchNameConsistencyDetect.SimilarityFactor; f < 0 || f > 1 {
+		return fmt.Errorf("%w: BranchNameConsistencyDetect.similarityFactor must be between 0 and 1, got %v",
+			ErrInvalidParameter, f)
+	}
+
+	for _, ext := range p.BinaryDetect.Extensions {
+		if !strings.HasPrefix(ext, ".") {
+			return fmt.Errorf("%w: BinaryDetect.extensions must start with a dot, got %s", ErrInvalidParameter, ext)
+		}
+	}
+
+	for _, t := range p.ConventionalCommitDetect.Types {
+		if t == "" || strings.ContainsAny(t, " ():!") {
+			return fmt.Errorf("%w: ConventionalCommitDetect.types must be single words, got %q", ErrInvalidParameter, t)
+		}
+	}
+
+	for _, r := range p.CommitMessageLintDetect.Rules {
+		if !lint.IsRule(r) {
+			return fmt.Errorf("%w: CommitMessageLintDetect.rules must be one of %s, got %q",
+				ErrInvalidParameter, strings.Join(lintRules(), ", "), r)
+		}
+	}
+
+	if p.CommitMessageLintDetect.MaxSubjectLength < 0 || p.CommitMessageLintDetect.BodyWrapWidth < 0 {
+		return fmt.Errorf("%w: CommitMessageLintDetect lengths must be positive", ErrInvalidParameter)
+	}
+
+	if e := p.SecretDetector.EntropyThreshold; e < 0 || e > 8 {
+		return fmt.Errorf("%w: SecretDetector.entropyThreshold must be between 0 and 8, got %v", ErrInvalidParameter, e)
+	}
+
+	for _, f := range p.SecretDetector.Filenames {
+		if _, err := path.Match(f, ""); err != nil {
+			return fmt.Errorf("%w: SecretDetector.filenames has an invalid pattern %q", ErrInvalidParameter, f)
+		}
+	}
+
+	if p.LargeFileDetector.MaxSizeKB < 0 {
+		return fmt.Errorf("%w: LargeFileDetector.maxSizeKB must be positive, got %d",
+			ErrInvalidParameter, p.LargeFileDetector.MaxSizeKB)
+	}
+
+	for _, m := range p.FixupCommitDetector.Markers {
+		if strings.TrimSpace(m) == "" {
+			return fmt.Errorf("%w: FixupCommitDetector.markers can not be empty", ErrInvalidParameter)
+		}
+	}
+
+	if p.BranchDivergenceDetect.MaxBehind < 0 || p.BranchDivergenceDetect.MaxAgeDays < 0 {
+		return fmt.Errorf("%w: BranchDivergenceDetect thresholds must be positive", ErrInvalidParameter)
+	}
+
+	if ps := p.PullRequestSizeDetector; ps.MaxLines < 0 || ps.MaxFiles < 0 || ps.MaxCommits < 0 {
+		return fmt.Errorf("%w: PullRequestSizeDetector thresholds must be positive", ErrInvalidParameter)
+	}
+
+	for _, g := range p.PullRequestSizeDetector.GeneratedPaths {
+		if _, err := path.Match(strings.TrimSuffix(g, "/"), ""); err != nil {
+			return fmt.Errorf("%w: PullRequestSizeDetector.generatedPaths has an invalid pattern %q", ErrInvalidParameter, g)
+		}
+	}
+
+	if rs := p.PullRequestRubberStampDetector; rs.MaxLinesPerMinute < 0 || rs.MinLines < 0 {
+		return fmt.Errorf("%w: PullRequestRubberStampDetector thresholds must be positive", ErrInvalidParameter)
+	}
+
+	if ts := p.CommitTimestampDetector; ts.MaxClockSkewMinutes < 0 || ts.MaxDateGapDays < 0 {
+		return fmt.Errorf("%w: CommitTimestampDetector thresholds must be positive", ErrInvalidParameter)
+	}
+
+	if p.StaleIssueDetector.Days < 0 {
+		return fmt.Errorf("%w: StaleIssueDetector.days must be positive, got %d",
+			ErrInvalidParameter, p.StaleIssueDetector.Days)
+	}
+
+	for _, pack := range p.BuildArtifactDetector.Packs {
+		if _, ok := ArtifactPacks[pack]; !ok {
+			return fmt.Errorf("%w: BuildArtifactDetector.packs has an unknown pack %q", ErrInvalidParameter, pack)
+		}
+	}
+
+	for _, a := range p.BuildArtifactDetector.Paths {
+		if _, err := path.Match(strings.TrimSuffix(a, "/"), ""); err != nil {
+			return fmt.Errorf("%w: BuildArtifactDetector.paths has an invalid pattern %q", ErrInvalidParameter, a)
+		}
+	}
+
+	ta := p.TestAccompanimentDetector
+	if ta.MinRatio < 0 || ta.MinRatio > 1 {
+		return fmt.Errorf("%w: TestAccompanimentDetector.minRatio must be between 0 and 1, got %v",
+			ErrInvalidParameter, ta.MinRatio)
+	}
+
+	for _, t := range ta.TestPaths {
+		if _, err := path.Match(strings.TrimSuffix(t, "/"), ""); err != nil {
+			return fmt.Errorf("%w: TestAccompanimentDetector.testPaths has an invalid pattern %q", ErrInvalidParameter, t)
+		}
+	}
+
+	for _, ext := range ta.SourceExtensions {
+		if !strings.HasPrefix(ext, ".") {
+			return fmt.Errorf("%w: TestAccompanimentDetector.sourceExtensions must start with a dot, got %s",
+				ErrInvalidParameter, ext)
+		}
+	}
+
+	for role, rules := range p.BranchNamingDetect.Rules {
+		if !isBranchRole(role) {
+			return fmt.Errorf("%w: BranchNamingDetect.rules roles must be one of %s, got %q",
+				ErrInvalidParameter, strings.Join(branchRoles, ", "), role)
+		}
+
+		for _, rule := range rules {
+			if err := validateBranchRule(rule); err != nil {
+				return fmt.Errorf("%w: BranchNamingDetect.rules has an invalid %s rule %q: %v",
+					ErrInvalidParameter, role, rule, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isBranchRole(role string) bool {
+	for _, r := range branchRoles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateBranchRule checks a branch naming rule is a valid regular expression or glob pattern.
+func validateBranchRule(rule string) error {
+	if strings.HasPrefix(rule, "^") {
+		_, err := regexp.Compile(rule)
+
+		return err //nolint: wrapcheck
+	}
+
+	_, err := path.Match(rule, "")
+
+	return err //nolint: wrapcheck
+}
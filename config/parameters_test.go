@@ -0,0 +1,160 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDefaultParameters(t *testing.T) {
+	cfg, err := Default()
+	if err != nil {
+		t.Fatalf("Default() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Parameters, DefaultParameters()) {
+		t.Errorf("Default() parameters = %+v, want %+v", cfg.Parameters, DefaultParameters())
+	}
+}
+
+func TestReadParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    func(p *Parameters)
+		wantErr bool
+	}{
+		{
+			"left_out",
+			`{"detectors": {}}`,
+			func(p *Parameters) {},
+			false,
+		},
+		{
+			"partial",
+			`{"parameters": {"StaleBranchDetect": {"days": 90}, "BinaryDetect": {"extensions": []}}}`,
+			func(p *Parameters) {
+				p.StaleBranchDetect.Days = 90
+				p.BinaryDetect.Extensions = []string{}
+			},
+			false,
+		},
+		{
+			"zero",
+			`{"parameters": {"TestAccompanimentDetector": {"minRatio": 0}, "SecretDetector": {"entropyThreshold": 0}}}`,
+			func(p *Parameters) {
+				p.TestAccompanimentDetector.MinRatio = 0
+				p.SecretDetector.EntropyThreshold = 0
+			},
+			false,
+		},
+		{
+			"negative_days",
+			`{"parameters": {"StaleBranchDetect": {"days": -1}}}`,
+			nil,
+			true,
+		},
+		{
+			"similarity_factor_range",
+			`{"parameters": {"BranchNameConsistencyDetect": {"similarityFactor": 1.5}}}`,
+			nil,
+			true,
+		},
+		{
+			"lint_rules",
+			`{"parameters": {"CommitMessageLintDetect": {"rules": ["trailing-period"], "maxSubjectLength": 50}}}`,
+			func(p *Parameters) {
+				p.CommitMessageLintDetect.Rules = []string{"trailing-period"}
+				p.CommitMessageLintDetect.MaxSubjectLength = 50
+			},
+			false,
+		},
+		{
+			"unknown_lint_rule",
+			`{"parameters": {"CommitMessageLintDetect": {"rules": ["subject-case"]}}}`,
+			nil,
+			true,
+		},
+		{
+			"branch_rules",
+			`{"parameters": {"BranchNamingDetect": {"rules": {"feature": ["^feature/(?P<issue>\\d+)-"]}}}}`,
+			func(p *Parameters) {
+				p.BranchNamingDetect.Rules = map[string][]string{"feature": {`^feature/(?P<issue>\d+)-`}}
+			},
+			false,
+		},
+		{
+			"unknown_branch_role",
+			`{"parameters": {"BranchNamingDetect": {"rules": {"bugfix": ["bugfix/*"]}}}}`,
+			nil,
+			true,
+		},
+		{
+			"invalid_branch_rule",
+			`{"parameters": {"BranchNamingDetect": {"rules": {"feature": ["^feature/(\\d+"]}}}}`,
+			nil,
+			true,
+		},
+		{
+			"artifact_packs",
+			`{"parameters": {"BuildArtifactDetector": {"packs": ["node"], "paths": ["dist/"]}}}`,
+			func(p *Parameters) {
+				p.BuildArtifactDetector.Packs = []string{"node"}
+				p.BuildArtifactDetector.Paths = []string{"dist/"}
+			},
+			false,
+		},
+		{
+			"unknown_artifact_pack",
+			`{"parameters": {"BuildArtifactDetector": {"packs": ["cobol"]}}}`,
+			nil,
+			true,
+		},
+		{
+			"test_min_ratio",
+			`{"parameters": {"TestAccompanimentDetector": {"minRatio": 0.8}}}`,
+			func(p *Parameters) { p.TestAccompanimentDetector.MinRatio = 0.8 },
+			false,
+		},
+		{
+			"test_min_ratio_above_one",
+			`{"parameters": {"TestAccompanimentDetector": {"minRatio": 80}}}`,
+			nil,
+			true,
+		},
+		{
+			"extension_without_dot",
+			`{"parameters": {"BinaryDetect": {"extensions": ["exe"]}}}`,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), DefaultConfigPath)
+			if err := os.WriteFile(path, []byte(tt.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Read(path)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidParameter) {
+					t.Errorf("Read() error = %v, want %v", err, ErrInvalidParameter)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			want := DefaultParameters()
+			tt.want(&want)
+			if !reflect.DeepEqual(cfg.Parameters, want) {
+				t.Errorf("Read() parameters = %+v, want %+v", cfg.Parameters, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package conventional
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Breaking change footer tokens, the hyphenated form is a synonym.
+const (
+	BreakingChange       = "BREAKING CHANGE"
+	BreakingChangeHyphen = "BREAKING-CHANGE"
+)
+
+var (
+	ErrEmptyMessage     = errors.New("commit message is empty")
+	ErrMissingType      = errors.New("commit message is missing a type")
+	ErrInvalidScope     = errors.New("commit message has an invalid scope")
+	ErrMissingSeparator = errors.New("commit message type must be followed by a colon and a space")
+	ErrMissingSubject   = errors.New("commit message is missing a description")
+	ErrMissingBlankLine = errors.New("commit message body must begin one blank line after the description")
+
+	typePattern   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*`)
+	footerPattern = regexp.MustCompile(`^(BREAKING CHANGE|BREAKING-CHANGE|[A-Za-z][A-Za-z0-9-]*)(: | #)(.*)$`)
+)
+
+// Footer is a trailer of a commit message, eg: "Refs: #123" or "BREAKING CHANGE: removed flag".
+type Footer struct {
+	Token     string
+	Separator string // Either ": " or " #".
+	Value     string
+}
+
+// Message is a commit message parsed with the Conventional Commits grammar.
+// See https://www.conventionalcommits.org/en/v1.0.0/#specification.
+//
+//	<type>[(<scope>)][!]: <description>
+//
+//	[body]
+//
+//	[footers]
+type Message struct {
+	Type        string
+	Scope       string
+	Breaking    bool // Set by "!" before the colon or a breaking change footer.
+	Description string
+	Body        string
+	Footers     []Footer
+}
+
+// Parse parses a commit message, returning why it does not conform to the grammar.
+func Parse(message string) (*Message, error) {
+	message = strings.TrimRight(strings.ReplaceAll(message, "\r\n", "\n"), "\n\t ")
+	if strings.TrimSpace(message) == "" {
+		return nil, ErrEmptyMessage
+	}
+
+	lines := strings.Split(message, "\n")
+
+	m, err := parseHeader(lines[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 1 {
+		return m, nil
+	}
+
+	if strings.TrimSpace(lines[1]) != "" {
+		return nil, ErrMissingBlankLine
+	}
+
+	rest := lines[2:]
+	start := footerStart(rest)
+	m.Body = strings.TrimSpace(strings.Join(rest[:start], "\n"))
+	m.Footers = parseFooters(rest[start:])
+
+	for _, f := range m.Footers {
+		if f.Token == BreakingChange || f.Token == BreakingChangeHyphen {
+			m.Breaking = true
+		}
+	}
+
+	return m, nil
+}
+
+// parseHeader parses "<type>[(<scope>)][!]: <description>".
+func parseHeader(header string) (*Message, error) {
+	m := &Message{}
+
+	m.Type = typePattern.FindString(header)
+	if m.Type == "" {
+		return nil, fmt.Errorf("%w: %q", ErrMissingType, header)
+	}
+	rest := header[len(m.Type):]
+
+	if strings.HasPrefix(rest, "(") {
+		closing := strings.Index(rest, ")")
+		if closing < 0 {
+			return nil, fmt.Errorf("%w: missing closing parenthesis", ErrInvalidScope)
+		}
+
+		m.Scope = rest[1:closing]
+		if strings.TrimSpace(m.Scope) == "" || strings.ContainsAny(m.Scope, "()") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, m.Scope)
+		}
+		rest = rest[closing+1:]
+	}
+
+	if strings.HasPrefix(rest, "!") {
+		m.Breaking = true
+		rest = rest[1:]
+	}
+
+	if !strings.HasPrefix(rest, ": ") {
+		return nil, fmt.Errorf("%w: %q", ErrMissingSeparator, header)
+	}
+
+	m.Description = strings.TrimSpace(rest[2:])
+	if m.Description == "" {
+		return nil, ErrMissingSubject
+	}
+
+	return m, nil
+}
+
+// footerStart finds the first paragraph that starts with a footer, the footers run to the end of the message.
+func footerStart(lines []string) int {
+	for i, line := range lines {
+		if (i == 0 || strings.TrimSpace(lines[i-1]) == "") && footerPattern.MatchString(line) {
+			return i
+		}
+	}
+
+	return len(lines)
+}
+
+// parseFooters parses footers, lines that do not start a footer continue the value of the previous footer.
+func parseFooters(lines []string) []Footer {
+	var footers []Footer
+
+	for _, line := range lines {
+		if match := footerPattern.FindStringSubmatch(line); match != nil {
+			footers = append(footers, Footer{Token: match[1], Separator: match[2], Value: match[3]})
+
+			continue
+		}
+
+		if len(footers) != 0 {
+			footers[len(footers)-1].Value += "\n" + line
+		}
+	}
+
+	for i := range footers {
+		footers[i].Value = strings.TrimSpace(footers[i].Value)
+	}
+
+	return footers
+}
+
+// Header formats the first line of the message.
+func (m *Message) Header() string {
+	var sb strings.Builder
+	sb.WriteString(m.Type)
+
+	if m.Scope != "" {
+		sb.WriteString("(" + m.Scope + ")")
+	}
+
+	if m.Breaking {
+		sb.WriteString("!")
+	}
+
+	sb.WriteString(": " + m.Description)
+
+	return sb.String()
+}
+
+// Footer finds the value of the first footer with the token, compared case insensitively.
+func (m *Message) Footer(token string) (string, bool) {
+	for _, f := range m.Footers {
+		if strings.EqualFold(f.Token, token) {
+			return f.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// BreakingChange describes the breaking change from the footer, falling back to the description.
+func (m *Message) BreakingChange() string {
+	if !m.Breaking {
+		return ""
+	}
+
+	for _, f := range m.Footers {
+		if f.Token == BreakingChange || f.Token == BreakingChangeHyphen {
+			return f.Value
+		}
+	}
+
+	return m.Description
+}
+
+// Bump is the semantic version increment a commit calls for.
+func (m *Message) Bump() Bump {
+	switch {
+	case m.Breaking:
+		return MajorBump
+	case strings.EqualFold(m.Type, "feat"):
+		return MinorBump
+	case strings.EqualFold(m.Type, "fix"):
+		return PatchBump
+	default:
+		return NoBump
+	}
+}
+
+// Bump is a semantic version increment.
+type Bump int
+
+const (
+	NoBump Bump = iota
+	PatchBump
+	MinorBump
+	MajorBump
+)
+
+// Bump string lookup.
+func (b Bump) String() string {
+	return [...]string{
+		"none",
+		"patch",
+		"minor",
+		"major",
+	}[b]
+}
+
+// SuggestBump is the largest increment of the messages, eg: the next release after the messages since the last tag.
+func SuggestBump(messages []*Message) Bump {
+	bump := NoBump
+	for _, m := range messages {
+		if b := m.Bump(); b > bump {
+			bump = b
+		}
+	}
+
+	return bump
+}
@@ -0,0 +1,117 @@
+package conventional
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    *Message
+		wantErr error
+	}{
+		{
+			"description",
+			"feat: add pagination\n",
+			&Message{Type: "feat", Description: "add pagination"},
+			nil,
+		},
+		{
+			"scope_breaking",
+			"fix(api)!: drop v1 endpoints",
+			&Message{Type: "fix", Scope: "api", Breaking: true, Description: "drop v1 endpoints"},
+			nil,
+		},
+		{
+			"body_footers",
+			"docs: update readme\n\nExplain the config.\n\nSecond paragraph.\n\nRefs #12\nReviewed-by: Gopher\nBREAKING CHANGE: config moved\n  to config.json",
+			&Message{
+				Type:        "docs",
+				Breaking:    true,
+				Description: "update readme",
+				Body:        "Explain the config.\n\nSecond paragraph.",
+				Footers: []Footer{
+					{Token: "Refs", Separator: " #", Value: "12"},
+					{Token: "Reviewed-by", Separator: ": ", Value: "Gopher"},
+					{Token: BreakingChange, Separator: ": ", Value: "config moved\n  to config.json"},
+				},
+			},
+			nil,
+		},
+		{"empty", " \n", nil, ErrEmptyMessage},
+		{"missing_type", "(api): change", nil, ErrMissingType},
+		{"unclosed_scope", "feat(api: change", nil, ErrInvalidScope},
+		{"empty_scope", "feat(): change", nil, ErrInvalidScope},
+		{"missing_separator", "Add pagination", nil, ErrMissingSeparator},
+		{"missing_description", "feat: ", nil, ErrMissingSeparator},
+		{"blank_description", "feat:  ", nil, ErrMissingSeparator},
+		{"missing_blank_line", "feat: add\nbody", nil, ErrMissingBlankLine},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.message)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	m, err := Parse("refactor(core)!: rename model\n\nBREAKING-CHANGE: EnrichedModel is now Model")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got := m.Header(); got != "refactor(core)!: rename model" {
+		t.Errorf("Header() = %s", got)
+	}
+
+	if got := m.BreakingChange(); got != "EnrichedModel is now Model" {
+		t.Errorf("BreakingChange() = %s", got)
+	}
+
+	if got, ok := m.Footer("breaking-change"); !ok || got != "EnrichedModel is now Model" {
+		t.Errorf("Footer() = %s, %v", got, ok)
+	}
+}
+
+func TestSuggestBump(t *testing.T) {
+	parse := func(messages ...string) []*Message {
+		parsed := make([]*Message, 0, len(messages))
+		for _, message := range messages {
+			m, err := Parse(message)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			parsed = append(parsed, m)
+		}
+
+		return parsed
+	}
+
+	tests := []struct {
+		name     string
+		messages []*Message
+		want     Bump
+	}{
+		{"none", parse("docs: readme", "chore: tidy"), NoBump},
+		{"patch", parse("docs: readme", "fix: crash"), PatchBump},
+		{"minor", parse("fix: crash", "feat: flag"), MinorBump},
+		{"major", parse("feat: flag", "chore!: drop go 1.17"), MajorBump},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuggestBump(tt.messages); got != tt.want {
+				t.Errorf("SuggestBump() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
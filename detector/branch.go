@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
@@ -80,15 +81,19 @@ func (b *BranchDetector) Name() string {
 }
 
 // GithubWorklow: Branches are considered stale after three months.
-func StaleBranchDetect() (string, BranchDetect) {
-	// NOTE: Course is currently using 2 weeks as stale branch time because of the short life of the project.
-	// We should make this configurable in the future!
+// NOTE: Course is currently using 2 weeks as stale branch time because of the short life of the project.
+func StaleBranchDetect(params config.StaleBranchParameters) (string, BranchDetect) {
 	secondsInWeek := 604800
-	staleBranchTime := time.Hour * 24 * 14 // 14 days
+	staleBranchTime := time.Hour * 24 * time.Duration(params.Days)
 
 	// secondsInMonth := 2600640
 
 	return "StaleBranchDetect", func(c *common, branch *local.Branch) (bool, violation.Violation, error) {
+		// Long lived branches such as develop or production are expected to be quiet at times.
+		if c.roles.LongLived(branch.Name) {
+			return false, nil, nil
+		}
+
 		if time.Since(branch.Head.Committer.When) > staleBranchTime {
 			email := branch.Head.Committer.Email
 			monthsSince := utils.RoundTime(time.Since(branch.Head.Committer.When).Seconds() / float64(secondsInWeek))
@@ -110,3 +115,83 @@ func StaleBranchDetect() (string, BranchDetect) {
 		return false, nil, nil
 	}
 }
+
+// BranchDivergenceDetect finds feature and hotfix branches that are far behind the branch they merge into,
+// or that forked from it too long ago, as they become harder to integrate.
+func BranchDivergenceDetect(params config.BranchDivergenceParameters) (string, BranchDetect) {
+	maxAge := time.Hour * 24 * time.Duration(params.MaxAgeDays)
+
+	return "BranchDivergenceDetect", func(c *common, branch *local.Branch) (bool, violation.Violation, error) {
+		if c.roles.LongLived(branch.Name) {
+			return false, nil, nil
+		}
+
+		d, ok := c.Divergence(branch.Name)
+		// Branches without commits of their own are even with the base or merged.
+		if !ok || d.Ahead == 0 {
+			return false, nil, nil
+		}
+
+		farBehind := d.Behind > params.MaxBehind
+		longLived := d.Age() > maxAge
+		if !farBehind && !longLived {
+			return false, nil, nil
+		}
+
+		return true, violation.NewBranchDivergenceViolation(
+			markup.Branch{
+				Name: branch.Name,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			d.Base,
+			d.Ahead,
+			d.Behind,
+			d.Age(),
+			farBehind,
+			longLived,
+			branch.Head.Committer.Email,
+			branch.Head.Committer.When,
+			c.IsCurrentBranch(branch.Name),
+		), nil
+	}
+}
+
+// MergedBranchDetect finds branches that were merged but not deleted, which clutter the branch list as much as
+// stale branches.
+func MergedBranchDetect() (string, BranchDetect) {
+	return "MergedBranchDetect", func(c *common, branch *local.Branch) (bool, violation.Violation, error) {
+		mb, ok := c.MergedBranch(branch.Name)
+		if !ok {
+			return false, nil, nil
+		}
+
+		var pr *markup.PR
+		if mb.PullRequest != nil {
+			pr = &markup.PR{
+				Number: mb.PullRequest.Number,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			}
+		}
+
+		return true, violation.NewMergedBranchViolation(
+			markup.Branch{
+				Name: branch.Name,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			mb.Base,
+			pr,
+			branch.Head.Committer.Email,
+			branch.Head.Committer.When,
+			c.IsCurrentBranch(branch.Name),
+		), nil
+	}
+}
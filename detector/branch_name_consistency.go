@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/violation"
@@ -15,8 +16,11 @@ import (
 // Branches must have consistent names.
 // Research: https://stackoverflow.com/questions/29476737/similarities-in-strings-for-name-matching
 // Methods: q-grams, longest common substring and longest common subsequence.
-func BranchNameConsistencyDetect() (string, BranchCompareDetect) {
+func BranchNameConsistencyDetect(params config.BranchNameConsistencyParameters) (string, BranchCompareDetect) {
 	return "BranchNameConsistencyDetect", func(c *common, branches []local.Branch) (int, []violation.Violation, error) {
+		// Only feature and hotfix branches follow a naming convention.
+		branches = filterLongLived(c, branches)
+
 		branchRefs := make([]string, len(branches))
 		for i, branch := range branches {
 			branchRefs[i] = branch.Name
@@ -44,7 +48,7 @@ func BranchNameConsistencyDetect() (string, BranchCompareDetect) {
 				continue
 			}
 			// does not follow substring
-			if ranking[i] < 0.175*float64(len(branches)) { // 0.175 is an adjustable value
+			if ranking[i] < params.SimilarityFactor*float64(len(branches)) {
 				// not consistent with others.
 				violations = append(
 					violations,
@@ -71,6 +75,18 @@ func BranchNameConsistencyDetect() (string, BranchCompareDetect) {
 	}
 }
 
+// filterLongLived removes branches with long lived roles such as primary, develop and release.
+func filterLongLived(c *common, branches []local.Branch) []local.Branch {
+	filtered := make([]local.Branch, 0, len(branches))
+	for _, branch := range branches {
+		if !c.roles.LongLived(branch.Name) {
+			filtered = append(filtered, branch)
+		}
+	}
+
+	return filtered
+}
+
 // sortByRanking sorts the input strings by their ranking.
 // implements sort.Interface
 // usage: sort.Sort(&sortByRanking{}).
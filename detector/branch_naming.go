@@ -0,0 +1,118 @@
+package detector
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/violation"
+)
+
+// branchRule is an allowed branch name, a glob pattern or a regular expression.
+type branchRule struct {
+	pattern string
+	re      *regexp.Regexp // Nil for glob patterns.
+}
+
+// newBranchRules compiles the rules, rules starting with ^ are regular expressions.
+func newBranchRules(patterns []string) ([]branchRule, error) {
+	rules := make([]branchRule, 0, len(patterns))
+	for _, pattern := range patterns {
+		rule := branchRule{pattern: pattern}
+		if strings.HasPrefix(pattern, "^") {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid branch naming rule %q: %w", pattern, err)
+			}
+			rule.re = re
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// match checks if the rule matches the branch name, returning the issue number captured by the rule or zero.
+func (r branchRule) match(name string) (bool, int) {
+	if r.re == nil {
+		ok, _ := path.Match(r.pattern, name)
+
+		return ok, 0
+	}
+
+	match := r.re.FindStringSubmatch(name)
+	if match == nil {
+		return false, 0
+	}
+
+	if i := r.re.SubexpIndex("issue"); i > 0 {
+		issue, _ := strconv.Atoi(strings.TrimPrefix(match[i], "#"))
+
+		return true, issue
+	}
+
+	return true, 0
+}
+
+// BranchNamingDetect checks branch names against the naming rules of their role, eg: feature/<issue>-<slug>.
+// Issue numbers captured by a rule must be issues of the repository, which is only checked when issues were scraped.
+func BranchNamingDetect(params config.BranchNamingParameters) (string, BranchDetect) {
+	rules := make(map[string][]branchRule, len(params.Rules))
+	var err error
+	for role, patterns := range params.Rules {
+		if rules[role], err = newBranchRules(patterns); err != nil {
+			break
+		}
+	}
+
+	return "BranchNamingDetect", func(c *common, branch *local.Branch) (bool, violation.Violation, error) {
+		if err != nil {
+			return false, nil, err
+		}
+
+		role := c.roles.Role(branch.Name).String()
+		roleRules, ok := rules[role]
+		if !ok || len(roleRules) == 0 {
+			return false, nil, nil
+		}
+
+		matched, issue := false, 0
+		for _, rule := range roleRules {
+			if matched, issue = rule.match(branch.Name); matched {
+				break
+			}
+		}
+
+		// Unknown issues are only reported when the repository has issues to compare with.
+		unknownIssue := matched && issue != 0 && c.issues != nil && c.issues[issue] == nil
+		if matched && !unknownIssue {
+			return false, nil, nil
+		}
+
+		patterns := make([]string, len(roleRules))
+		for i, rule := range roleRules {
+			patterns[i] = rule.pattern
+		}
+
+		return true, violation.NewBranchNamingViolation(
+			markup.Branch{
+				Name: branch.Name,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			role,
+			patterns,
+			issue,
+			branch.Head.Committer.Email,
+			branch.Head.Committer.When,
+			c.IsCurrentBranch(branch.Name),
+		), nil
+	}
+}
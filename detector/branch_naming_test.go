@@ -0,0 +1,67 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestBranchNamingDetect(t *testing.T) {
+	names := []string{
+		"main",
+		"feature/12-login",
+		"feature/99-signup",
+		"feature/12",
+		"login-page",
+		"hotfix/crash",
+		"release/1.2",
+		"release/next",
+	}
+	branches := make([]local.Branch, len(names))
+	for i, name := range names {
+		branches[i] = local.Branch{Name: name, Head: local.Commit{Hash: local.Hash{byte(i)}}}
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{Branches: branches}, remote.RemoteModel{
+		Owner:  "Git-Gopher",
+		Name:   "tests",
+		Issues: []*remote.Issue{{Number: 12}},
+	})
+	em.Roles = &enriched.BranchRoles{Primary: "main", Release: []string{"release/*"}, Hotfix: []string{"hotfix/*"}}
+
+	params := config.DefaultParameters().BranchNamingDetect
+	params.Rules["feature"] = []string{`^feature/(?P<issue>\d+)-[a-z0-9-]+$`}
+	d := NewBranchDetector(BranchNamingDetect(params))
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	_, _, total, violations := d.Result()
+	if total != len(names) {
+		t.Errorf("Result() total = %d, want %d", total, len(names))
+	}
+
+	link := func(name string) string {
+		return markup.Branch{Name: name, GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"}}.Markdown()
+	}
+	feature := "`^feature/(?P<issue>\\d+)-[a-z0-9-]+$`"
+	want := []string{
+		"Branch " + link("feature/99-signup") + " references issue #99, which does not exist",
+		"Branch " + link("feature/12") + " does not follow the naming rules of feature branches: " + feature,
+		"Branch " + link("login-page") + " does not follow the naming rules of feature branches: " + feature,
+		"Branch " + link("release/next") + " does not follow the naming rules of release branches: " +
+			"`^release/\\d+\\.\\d+(\\.\\d+)?$`",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("violations = %d, want %d", len(violations), len(want))
+	}
+	for i, v := range violations {
+		if v.Message() != want[i] {
+			t.Errorf("Message() = %s, want %s", v.Message(), want[i])
+		}
+	}
+}
@@ -2,7 +2,9 @@ package detector
 
 import (
 	"testing"
+	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
@@ -25,7 +27,7 @@ func TestStaleBranchDetect(t *testing.T) {
 				t.Errorf("TestTwoParentsCommitDetect() create model = %v", err)
 			}
 
-			detector := NewBranchDetector(StaleBranchDetect())
+			detector := NewBranchDetector(StaleBranchDetect(config.DefaultParameters().StaleBranchDetect))
 			enrichedModel := enriched.NewEnrichedModel(*gitModel, remote.RemoteModel{})
 			if err = detector.Run(enrichedModel); err != nil {
 				t.Errorf("TestStaleBranchDetect() run detector = %v", err)
@@ -35,3 +37,124 @@ func TestStaleBranchDetect(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchDivergenceDetect(t *testing.T) {
+	daysAgo := func(c local.Commit, days int) local.Commit {
+		c.Committer.When = time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+
+		return c
+	}
+	commit := func(hash byte, parents ...byte) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}}
+		for _, p := range parents {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{p})
+		}
+
+		return c
+	}
+
+	// 1 - 2 - 3 - 4   main
+	//  \       \
+	//   5       6     old, behind
+	commits := []local.Commit{
+		daysAgo(commit(6, 3), 1),
+		daysAgo(commit(5, 1), 40),
+		daysAgo(commit(4, 3), 1),
+		daysAgo(commit(3, 2), 2),
+		daysAgo(commit(2, 1), 3),
+		daysAgo(commit(1), 60),
+	}
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: commits,
+		Branches: []local.Branch{
+			{Name: "main", Head: commits[2]},
+			{Name: "old", Head: commits[1]},
+			{Name: "behind", Head: commits[0]},
+			{Name: "merged", Head: commits[3]},
+		},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+	if err := em.AssignBranchRoles(config.BranchRoles{Primary: "main"}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	// Only old is more than a commit behind.
+	d := NewBranchDetector(BranchDivergenceDetect(config.BranchDivergenceParameters{MaxBehind: 1, MaxAgeDays: 30}))
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	_, found, total, violations := d.Result()
+	if found != 1 || total != 4 || len(violations) != 1 {
+		t.Fatalf("Result() = %d, %d, %v, want 1, 4, 1 violation", found, total, violations)
+	}
+
+	want := "Branch [old](https://github.com/Git-Gopher/tests/tree/old) forked from \"main\" 60 days ago " +
+		"and is 3 commits behind, 1 commits ahead"
+	if got := violations[0].Message(); got != want {
+		t.Errorf("Message() = %s, want %s", got, want)
+	}
+}
+
+func TestMergedBranchDetect(t *testing.T) {
+	commit := func(hash byte, parents ...byte) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}}
+		for _, p := range parents {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{p})
+		}
+
+		return c
+	}
+
+	// 1 - 2 - 3 - 6   main; even at 2
+	//     |\     /
+	//     | 7 ---     merged
+	//      \
+	//       4 - 5     squashed by #7, wip
+	commits := []local.Commit{
+		commit(7, 2), commit(6, 3, 7), commit(5, 4), commit(4, 2), commit(3, 2), commit(2, 1), commit(1),
+	}
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: commits,
+		Branches: []local.Branch{
+			{Name: "main", Head: commits[1]},
+			{Name: "even", Head: commits[5]},
+			{Name: "merged", Head: commits[0]},
+			{Name: "squashed", Head: commits[3]},
+			{Name: "wip", Head: commits[2]},
+		},
+	}, remote.RemoteModel{
+		Owner: "Git-Gopher",
+		Name:  "tests",
+		PullRequests: []*remote.PullRequest{{
+			Number:      7,
+			Merged:      true,
+			HeadRefName: "squashed",
+			BaseRefName: "main",
+			HeadRefOid:  local.Hash{4}.HexString(),
+		}},
+	})
+	if err := em.AssignBranchRoles(config.BranchRoles{Primary: "main"}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	d := NewBranchDetector(MergedBranchDetect())
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	_, found, total, violations := d.Result()
+	if found != 2 || total != 5 || len(violations) != 2 {
+		t.Fatalf("Result() = %d, %d, %v, want 2, 5, 2 violations", found, total, violations)
+	}
+
+	want := []string{
+		"Branch [merged](https://github.com/Git-Gopher/tests/tree/merged) was merged into \"main\" but not deleted",
+		"Branch [squashed](https://github.com/Git-Gopher/tests/tree/squashed) was merged into \"main\" by " +
+			"[#7](https://github.com/Git-Gopher/tests/pull/7) but not deleted",
+	}
+	for i, v := range violations {
+		if v.Message() != want[i] {
+			t.Errorf("Message() = %s, want %s", v.Message(), want[i])
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package detector
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrBuildArtifactModelNil = errors.New("build artifact model is nil")
+
+// BuildArtifactDetector finds committed build artifacts, dependencies and editor files of the configured
+// ecosystems, and files that are still committed while the repository's own .gitignore ignores them. Generic
+// build directories only match next to the project files of their ecosystem, see config.ArtifactManifests.
+type BuildArtifactDetector struct {
+	name       string
+	violated   int // artifacts and ignored files
+	found      int // other files
+	total      int // total files added
+	violations []violation.Violation
+
+	params config.BuildArtifactParameters
+}
+
+// NewBuildArtifactDetector creates a new build artifact detector.
+func NewBuildArtifactDetector(name string, params config.BuildArtifactParameters) *BuildArtifactDetector {
+	return &BuildArtifactDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+	}
+}
+
+// artifactRule is a build artifact pattern and the pack it belongs to.
+type artifactRule struct {
+	pack    string
+	pattern string
+}
+
+// addedFiles are the files added by a commit that broke the same rule.
+type addedFiles struct {
+	commit *local.Commit
+	rule   artifactRule // Zero for ignored files.
+	files  []string
+}
+
+func (ba *BuildArtifactDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrBuildArtifactModelNil
+	}
+
+	ba.violated = 0
+	ba.found = 0
+	ba.total = 0
+	ba.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	var rules []artifactRule
+	exceptions := make(map[string][]string)
+	for _, pack := range ba.params.Packs {
+		for _, pattern := range config.ArtifactPacks[pack] {
+			if strings.HasPrefix(pattern, "!") {
+				exceptions[pack] = append(exceptions[pack], strings.TrimPrefix(pattern, "!"))
+
+				continue
+			}
+			rules = append(rules, artifactRule{pack: pack, pattern: pattern})
+		}
+	}
+	for _, pattern := range ba.params.Paths {
+		rules = append(rules, artifactRule{pack: "configured", pattern: pattern})
+	}
+
+	// Oldest first so files are reported by the commit that introduced them.
+	commits := make([]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[i] = &em.Commits[i]
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Author.When.Before(commits[j].Author.When)
+	})
+
+	introduced := make(map[string]*local.Commit)
+	deleted := make(map[string]bool)
+	var names []string
+
+	for _, commit := range commits {
+		// Merge commits repeat the changes of the merged branch.
+		if len(commit.ParentHashes) > 1 {
+			continue
+		}
+
+		for _, diff := range commit.DiffToParents {
+			// Deleted files have no blob after the commit.
+			deleted[diff.Name] = diff.Size == 0 && diff.Addition == "" && (diff.Deletion != "" || diff.IsBinary)
+			if _, ok := introduced[diff.Name]; !ok && !deleted[diff.Name] {
+				introduced[diff.Name] = commit
+				names = append(names, diff.Name)
+			}
+		}
+	}
+
+	// Files of each directory, to find the project files next to build directories.
+	files := make(map[string][]string)
+	for _, name := range names {
+		dir := path.Dir(name)
+		files[dir] = append(files[dir], path.Base(name))
+	}
+
+	ignored := local.NewIgnoreMatcher(em.IgnorePatterns)
+	groups := make(map[local.Hash]map[artifactRule]*addedFiles)
+	var order []*addedFiles
+
+	for _, name := range names {
+		commit := introduced[name]
+		ba.total++
+
+		rule, artifact := matchArtifact(name, rules, exceptions, files)
+		// Files that were deleted since no longer match the .gitignore.
+		if !artifact && (deleted[name] || !ignored.IsIgnored(name)) {
+			ba.found++
+
+			continue
+		}
+		ba.violated++
+
+		if groups[commit.Hash] == nil {
+			groups[commit.Hash] = make(map[artifactRule]*addedFiles)
+		}
+		group, ok := groups[commit.Hash][rule]
+		if !ok {
+			group = &addedFiles{commit: commit, rule: rule}
+			groups[commit.Hash][rule] = group
+			order = append(order, group)
+		}
+		group.files = append(group.files, name)
+	}
+
+	for _, group := range order {
+		commit := markup.Commit{
+			Hash: group.commit.Hash.HexString(),
+			GitHubLink: markup.GitHubLink{
+				Owner: c.owner,
+				Repo:  c.repo,
+			},
+		}
+		current := c.IsCurrentCommit(group.commit.Hash)
+
+		if group.rule == (artifactRule{}) {
+			ba.violations = append(ba.violations, violation.NewIgnoredFileViolation(
+				commit,
+				group.files,
+				group.commit.Author.Email,
+				group.commit.Author.When,
+				current,
+			))
+
+			continue
+		}
+
+		ba.violations = append(ba.violations, violation.NewBuildArtifactViolation(
+			commit,
+			group.rule.pack,
+			group.rule.pattern,
+			group.files,
+			group.commit.Author.Email,
+			group.commit.Author.When,
+			current,
+		))
+	}
+
+	return nil
+}
+
+func (ba *BuildArtifactDetector) Result() (int, int, int, []violation.Violation) {
+	return ba.violated, ba.found, ba.total, ba.violations
+}
+
+func (ba *BuildArtifactDetector) Name() string {
+	return ba.name
+}
+
+// matchArtifact finds the first rule the file matches, that is not an exception of the pack of the rule.
+// Directories of packs with project files only match next to one of the files.
+func matchArtifact(
+	name string,
+	rules []artifactRule,
+	exceptions map[string][]string,
+	files map[string][]string,
+) (artifactRule, bool) {
+	for _, rule := range rules {
+		if matchPath(name, exceptions[rule.pack]) {
+			continue
+		}
+
+		manifests := config.ArtifactManifests[rule.pack]
+		if !strings.HasSuffix(rule.pattern, "/") || len(manifests) == 0 {
+			if matchPath(name, []string{rule.pattern}) {
+				return rule, true
+			}
+
+			continue
+		}
+
+		for _, dir := range artifactParents(name, rule.pattern) {
+			for _, file := range files[dir] {
+				if matchPath(file, manifests) {
+					return rule, true
+				}
+			}
+		}
+	}
+
+	return artifactRule{}, false
+}
+
+// artifactParents finds the directories containing a directory of the file matching the directory pattern,
+// "." for the root.
+func artifactParents(name, pattern string) []string {
+	dir := strings.TrimSuffix(pattern, "/")
+	segments := strings.Split(name, "/")
+
+	var parents []string
+	for i, segment := range segments[:len(segments)-1] {
+		if ok, err := path.Match(dir, segment); err == nil && ok {
+			parents = append(parents, path.Join(append([]string{"."}, segments[:i]...)...))
+		}
+	}
+
+	return parents
+}
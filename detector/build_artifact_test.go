@@ -0,0 +1,103 @@
+package detector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestBuildArtifactDetector(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(hash byte, email string, diffs ...local.Diff) local.Commit {
+		return local.Commit{
+			Hash:          local.Hash{hash},
+			ParentHashes:  []local.Hash{{hash - 1}},
+			Author:        local.Signature{Email: email, When: start.Add(time.Hour * time.Duration(hash))},
+			DiffToParents: diffs,
+		}
+	}
+	added := func(name string) local.Diff {
+		return local.Diff{Name: name, Addition: "x\n", Size: 2}
+	}
+	removed := func(name string) local.Diff {
+		return local.Diff{Name: name, Deletion: "x\n"}
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(5, "gopher@example.com",
+				added("gradle/wrapper/gradle-wrapper.jar"), added("libs/util.jar"),
+				added("svc/Cargo.toml"), added("svc/target/debug/svc"),
+				added("api/pom.xml"), added("api/target/classes/App.class"),
+				added("scripts/bin/run.sh"),
+			),
+			commit(4, "gopher@example.com", removed("old.log"), added("app.log")),
+			commit(3, "gopher@example.com", local.Diff{Name: "main.go", Deletion: "x\n", Addition: "y\n", Size: 2}),
+			commit(2, "student@example.com",
+				added("main.go"), added("node_modules/react/index.js"), added("node_modules/react/package.json"),
+				added("web/.DS_Store"), added("debug.log"), added("old.log"), added("dist/app.js"),
+			),
+		},
+		IgnorePatterns: local.ParseIgnorePatterns("", "*.log\n!debug.log\nnode_modules/\n"),
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	d := NewBuildArtifactDetector("BuildArtifactDetector", config.BuildArtifactParameters{
+		Packs: []string{"node", "os", "java", "rust", "dotnet"},
+		Paths: []string{"dist/"},
+	})
+	if err := d.Run(em); err != nil {
+		t.Fatal(err)
+	}
+
+	violated, found, total, violations := d.Result()
+	if violated != 8 || found != 7 || total != 15 {
+		t.Errorf("Result() = %d, %d, %d, want 8, 7, 15", violated, found, total)
+	}
+
+	link := markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"}
+	two := markup.Commit{GitHubLink: link, Hash: local.Hash{2}.HexString()}.Markdown()
+	four := markup.Commit{GitHubLink: link, Hash: local.Hash{4}.HexString()}.Markdown()
+	five := markup.Commit{GitHubLink: link, Hash: local.Hash{5}.HexString()}.Markdown()
+	want := []struct {
+		message string
+		email   string
+	}{
+		{
+			"Commit " + two + " added 2 node files matching `node_modules/`, " +
+				"eg: `node_modules/react/index.js`, `node_modules/react/package.json`",
+			"student@example.com",
+		},
+		{"Commit " + two + " added 1 os files matching `.DS_Store`, eg: `web/.DS_Store`", "student@example.com"},
+		{"Commit " + two + " added 1 configured files matching `dist/`, eg: `dist/app.js`", "student@example.com"},
+		{
+			"Commit " + four + " added 1 files that are ignored by .gitignore but still committed, eg: `app.log`",
+			"gopher@example.com",
+		},
+		// The Gradle wrapper is committed, the bin/ directory has no .NET project next to it.
+		{"Commit " + five + " added 1 java files matching `*.jar`, eg: `libs/util.jar`", "gopher@example.com"},
+		// Each target/ directory belongs to the project next to it.
+		{"Commit " + five + " added 1 rust files matching `target/`, eg: `svc/target/debug/svc`", "gopher@example.com"},
+		{
+			"Commit " + five + " added 1 java files matching `target/`, eg: `api/target/classes/App.class`",
+			"gopher@example.com",
+		},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(violations), len(want))
+	}
+
+	for i, v := range violations {
+		if got := v.Message(); got != want[i].message {
+			t.Errorf("Message() = %q, want %q", got, want[i].message)
+		}
+
+		if got, _ := v.Email(); got != want[i].email {
+			t.Errorf("Email() = %q, want %q", got, want[i].email)
+		}
+	}
+}
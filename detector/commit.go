@@ -2,8 +2,11 @@ package detector
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/lint"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
@@ -152,15 +155,11 @@ func UnresolvedDetect() (string, CommitDetect) {
 	}
 }
 
-// Check if commit is less than 3 words.
-func ShortCommitMessageDetect() (string, CommitDetect) {
+// Check if commit is less than the minimum number of words.
+func ShortCommitMessageDetect(params config.ShortCommitMessageParameters) (string, CommitDetect) {
 	return "ShortCommitMessageDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
-		exclusions := []string{
-			"first commit",
-			"initial commit",
-		}
-		for _, exclusion := range exclusions {
-			if strings.ToLower(commit.Message) == exclusion {
+		for _, exclusion := range params.Exclusions {
+			if strings.EqualFold(commit.Message, exclusion) {
 				return true, nil, nil
 			}
 		}
@@ -170,7 +169,7 @@ func ShortCommitMessageDetect() (string, CommitDetect) {
 		}
 
 		words := strings.Split(commit.Message, " ")
-		if len(words) < 5 {
+		if len(words) < params.MinWords {
 			return false, []violation.Violation{violation.NewShortCommitViolation(
 				markup.Commit{
 					Hash: hex.EncodeToString(commit.Hash.ToByte()),
@@ -180,6 +179,7 @@ func ShortCommitMessageDetect() (string, CommitDetect) {
 					},
 				},
 				commit.Message,
+				params.MinWords,
 				commit.Committer.Email,
 				commit.Committer.When,
 				c.IsCurrentCommit(commit.Hash),
@@ -190,14 +190,12 @@ func ShortCommitMessageDetect() (string, CommitDetect) {
 	}
 }
 
-func BinaryDetect() (string, CommitDetect) {
-	// Extensions that should not be committed to the repository.
-	disallowedExtensions := []string{".exe", ".jar", ".class"}
-
+// BinaryDetect checks for committed binaries with extensions that should not be in the repository.
+func BinaryDetect(params config.BinaryParameters) (string, CommitDetect) {
 	return "BinaryDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
 		vs := []violation.Violation{}
 		for _, d := range commit.DiffToParents {
-			if d.IsBinary && utils.Contains(d.Name, disallowedExtensions) {
+			if d.IsBinary && utils.Contains(d.Name, params.Extensions) {
 				vs = append(vs, violation.NewBinaryViolation(
 					markup.File{
 						Commit: markup.Commit{
@@ -227,7 +225,8 @@ func EmptyCommitDetect() (string, CommitDetect) {
 		for _, d := range commit.DiffToParents {
 			addition += len(d.Addition)
 			deletion += len(d.Deletion)
-			hasBinary = hasBinary || d.IsBinary
+			// Mode changes have no content, but are not empty.
+			hasBinary = hasBinary || d.IsBinary || d.ModeChanged
 		}
 
 		vs := []violation.Violation{}
@@ -250,3 +249,128 @@ func EmptyCommitDetect() (string, CommitDetect) {
 		return isEmpty, vs, nil
 	}
 }
+
+// FormattingCommitDetect finds commits that only change formatting: file modes, line endings, whitespace or
+// line breaks. Merge commits are skipped, their diff to the first parent is the merged work.
+func FormattingCommitDetect() (string, CommitDetect) {
+	return "FormattingCommitDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
+		kind := commit.ChangeKind()
+		if len(commit.ParentHashes) >= 2 || !kind.IsFormatting() {
+			return false, nil, nil
+		}
+
+		return true, []violation.Violation{violation.NewFormattingCommitViolation(
+			markup.Commit{
+				Hash: commit.Hash.HexString(),
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			kind.String(),
+			len(commit.DiffToParents),
+			commit.Committer.Email,
+			commit.Committer.When,
+			c.IsCurrentCommit(commit.Hash),
+		)}, nil
+	}
+}
+
+// ConventionalCommitDetect checks that commits on the primary branch follow the Conventional Commits specification.
+func ConventionalCommitDetect(params config.ConventionalCommitParameters) (string, CommitDetect) {
+	return "ConventionalCommitDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
+		// Merge commits are generated by git or GitHub.
+		if len(commit.ParentHashes) > 1 || !c.IsPrimaryCommit(commit.Hash) {
+			return false, nil, nil
+		}
+
+		var reason string
+		message, err := commit.Conventional()
+		switch {
+		case err != nil:
+			reason = err.Error()
+		case !containsFold(params.Types, message.Type):
+			reason = fmt.Sprintf("type \"%s\" is not allowed", message.Type)
+		case message.Scope != "" && len(params.Scopes) != 0 && !containsFold(params.Scopes, message.Scope):
+			reason = fmt.Sprintf("scope \"%s\" is not allowed, use one of %s", message.Scope, strings.Join(params.Scopes, ", "))
+		default:
+			return true, nil, nil
+		}
+
+		return false, []violation.Violation{violation.NewConventionalCommitViolation(
+			markup.Commit{
+				Hash: commit.Hash.HexString(),
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			commit.Message,
+			reason,
+			params.Types,
+			commit.Committer.Email,
+			commit.Committer.When,
+			c.IsCurrentCommit(commit.Hash),
+		)}, nil
+	}
+}
+
+// CommitMessageLintDetect checks commit messages against the enabled style rules.
+func CommitMessageLintDetect(params config.CommitMessageLintParameters) (string, CommitDetect) {
+	rules := make([]lint.Rule, len(params.Rules))
+	for i, r := range params.Rules {
+		rules[i] = lint.Rule(r)
+	}
+
+	opts := lint.Options{
+		Rules:            rules,
+		MaxSubjectLength: params.MaxSubjectLength,
+		BodyWrapWidth:    params.BodyWrapWidth,
+		GenericSubjects:  params.GenericSubjects,
+	}
+
+	return "CommitMessageLintDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
+		// Merge commit messages are generated by git or GitHub.
+		if len(commit.ParentHashes) > 1 {
+			return false, nil, nil
+		}
+
+		problems := lint.Lint(commit.Message, opts)
+		if len(problems) == 0 {
+			return true, nil, nil
+		}
+
+		vs := make([]violation.Violation, 0, len(problems))
+		for _, p := range problems {
+			vs = append(vs, violation.NewCommitMessageLintViolation(
+				markup.Commit{
+					Hash: commit.Hash.HexString(),
+					GitHubLink: markup.GitHubLink{
+						Owner: c.owner,
+						Repo:  c.repo,
+					},
+				},
+				commit.Message,
+				string(p.Rule),
+				p.Message,
+				p.Suggestion,
+				commit.Committer.Email,
+				commit.Committer.When,
+				c.IsCurrentCommit(commit.Hash),
+			))
+		}
+
+		return false, vs, nil
+	}
+}
+
+// containsFold checks if the values contain the value, compared case insensitively.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
@@ -196,7 +197,7 @@ func TestBinaryDetect(t *testing.T) {
 
 			enrichedModel := enriched.NewEnrichedModel(*gitModel, remote.RemoteModel{})
 
-			detector := NewCommitDetector(BinaryDetect())
+			detector := NewCommitDetector(BinaryDetect(config.DefaultParameters().BinaryDetect))
 			if err = detector.Run(enrichedModel); err != nil {
 				t.Errorf(" TestBinaryDetect() run detector = %v", err)
 			}
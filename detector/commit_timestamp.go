@@ -0,0 +1,115 @@
+package detector
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrCommitTimestampModelNil = errors.New("commit timestamp model is nil")
+
+// CommitTimestampDetector finds commits with clock skewed, out of order or backdated timestamps,
+// which would otherwise be misplaced by date cutoffs. Commits backdated across the cutoff date are violations,
+// other author and committer date gaps are suggestions.
+type CommitTimestampDetector struct {
+	name       string
+	violated   int // commits with untrusted timestamps
+	found      int // commits with plausible timestamps
+	total      int // all commits
+	violations []violation.Violation
+
+	params config.CommitTimestampParameters
+	cutoff time.Time // Deadline of the work, zero when there is none.
+}
+
+// NewCommitTimestampDetector creates a new commit timestamp detector. Commits authored before the cutoff but
+// committed after it were backdated, a zero cutoff disables the check.
+func NewCommitTimestampDetector(
+	name string,
+	params config.CommitTimestampParameters,
+	cutoff time.Time,
+) *CommitTimestampDetector {
+	return &CommitTimestampDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+		cutoff:     cutoff,
+	}
+}
+
+func (ct *CommitTimestampDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrCommitTimestampModelNil
+	}
+
+	ct.violated = 0
+	ct.found = 0
+	ct.total = len(em.Commits)
+	ct.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	commits := make(map[local.Hash]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[em.Commits[i].Hash] = &em.Commits[i]
+	}
+
+	anomalies := em.TimestampAnomalies(
+		time.Now(),
+		ct.cutoff,
+		time.Duration(ct.params.MaxClockSkewMinutes)*time.Minute,
+		time.Duration(ct.params.MaxDateGapDays)*24*time.Hour,
+	)
+
+	for _, anomaly := range anomalies {
+		commit := commits[anomaly.Commit]
+
+		// Rebasing and amending also move the committer date, so a gap alone is only a suggestion.
+		severity := violation.Violated
+		if anomaly.Kind == enriched.DateGap {
+			severity = violation.Suggestion
+		} else {
+			ct.violated++
+		}
+
+		ct.violations = append(ct.violations, violation.NewCommitTimestampViolation(
+			markup.Commit{
+				Hash: commit.Hash.HexString(),
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			anomaly.Kind.String(),
+			commit.Author.When,
+			commit.Committer.When,
+			anomaly.Reference,
+			severity,
+			commit.Author.Email,
+			c.IsCurrentCommit(commit.Hash),
+		))
+	}
+	ct.found = ct.total - ct.violated
+
+	return nil
+}
+
+func (ct *CommitTimestampDetector) Result() (int, int, int, []violation.Violation) {
+	return ct.violated, ct.found, ct.total, ct.violations
+}
+
+func (ct *CommitTimestampDetector) Name() string {
+	return ct.name
+}
@@ -0,0 +1,73 @@
+package detector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/violation"
+)
+
+func TestCommitTimestampDetector(t *testing.T) {
+	now := time.Now()
+	commit := func(hash byte, author, committer time.Time, parents ...byte) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}}
+		c.Author.When, c.Committer.When = author, committer
+		for _, p := range parents {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{p})
+		}
+
+		return c
+	}
+	daysAgo := func(days int) time.Time {
+		return now.Add(-time.Duration(days) * 24 * time.Hour)
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(4, daysAgo(-7), daysAgo(-7), 3), // Clock a week ahead.
+			commit(3, daysAgo(40), daysAgo(2), 2),  // Rebased a month later.
+			commit(2, daysAgo(50), daysAgo(50), 1),
+			commit(1, daysAgo(60), daysAgo(60)),
+		},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	params := config.DefaultParameters().CommitTimestampDetector
+	d := NewCommitTimestampDetector("CommitTimestampDetector", params, time.Time{})
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	violated, found, total, violations := d.Result()
+	if violated != 1 || found != 3 || total != 4 {
+		t.Errorf("Result() = %d, %d, %d, want 1, 3, 4", violated, found, total)
+	}
+
+	if len(violations) != 2 {
+		t.Fatalf("violations = %d, want 2", len(violations))
+	}
+
+	if violations[0].Severity() != violation.Violated || violations[1].Severity() != violation.Suggestion {
+		t.Errorf("Severity() = %v, %v, want %v, %v",
+			violations[0].Severity(), violations[1].Severity(), violation.Violated, violation.Suggestion)
+	}
+
+	// Forged dates must not move the violations past a cutoff.
+	if filtered := violation.FilterByDate(violations, daysAgo(30)); len(filtered) != 2 {
+		t.Errorf("FilterByDate() = %d violations, want 2", len(filtered))
+	}
+
+	// Authored before the cutoff but committed after it, as with "git commit --date".
+	d = NewCommitTimestampDetector("CommitTimestampDetector", params, daysAgo(10))
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	violated, _, _, violations = d.Result()
+	if violated != 2 || len(violations) != 2 || violations[1].Severity() != violation.Violated {
+		t.Errorf("Result() with cutoff = %d, %v, want 2 violations", violated, violations)
+	}
+}
@@ -2,8 +2,10 @@ package detector
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/Git-Gopher/go-gopher/cache"
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
@@ -14,7 +16,9 @@ import (
 var (
 	ErrNotImplemented = fmt.Errorf("not implemented")
 
-	commonMemo *common
+	// Common objects of the models being analyzed, models can be analyzed concurrently.
+	commonMemo   = make(map[*enriched.EnrichedModel]*common)
+	commonMemoMu sync.Mutex
 )
 
 // common - common variables that are shared with all detectors.
@@ -27,6 +31,26 @@ type common struct {
 	mergingCommits []local.Hash
 	// Current pull request.
 	PR *remote.PullRequest
+	// Roles of the branches.
+	roles *enriched.BranchRoles
+	// Commits reachable from the primary branch.
+	primaryCommits map[local.Hash]struct{}
+	// Issues of the repository keyed by number, nil when issues were not scraped.
+	issues map[int]*remote.Issue
+	// Issues linked to a commit or pull request, keyed by issue number.
+	linkedIssues map[int]bool
+	// Numbers of the pull requests of the repository.
+	pullRequests map[int]bool
+	// Model the common object was created from.
+	em *enriched.EnrichedModel
+
+	// Costly fields are only computed when a detector needs them.
+	divergencesOnce    sync.Once
+	divergences        map[string]enriched.Divergence
+	templatesOnce      sync.Once
+	templates          map[int][]string
+	mergedBranchesOnce sync.Once
+	mergedBranches     map[string]enriched.MergedBranch
 }
 
 // Checks if a commit relates to the current feedback comment.
@@ -62,6 +86,34 @@ func (c *common) IsCurrentPR(pr *remote.PullRequest) bool {
 	return false
 }
 
+// Checks if a commit is part of the primary branch history.
+func (c *common) IsPrimaryCommit(h local.Hash) bool {
+	// Without a known primary branch, default to all commits.
+	if c.primaryCommits == nil {
+		return true
+	}
+
+	_, ok := c.primaryCommits[h]
+
+	return ok
+}
+
+// Checks if an issue is closed by the current pull request.
+func (c *common) IsCurrentIssue(number int) bool {
+	// In the case where there is no current pr, default to report all.
+	if c.PR == nil {
+		return true
+	}
+
+	for _, issue := range c.PR.ClosingIssues {
+		if issue.Number == number {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *common) IsCurrentBranch(branchName string) bool {
 	// In the case where there is no current branch/pr, default to report all.
 	if c.PR == nil {
@@ -75,29 +127,108 @@ func (c *common) IsCurrentBranch(branchName string) bool {
 	return false
 }
 
-// Create a common object from the enriched model.
+// Divergence of the branch from the branch it merges into.
+func (c *common) Divergence(branchName string) (enriched.Divergence, bool) {
+	c.divergencesOnce.Do(func() {
+		c.divergences = make(map[string]enriched.Divergence)
+		for _, d := range c.em.Divergences() {
+			c.divergences[d.Branch] = d
+		}
+	})
+
+	d, ok := c.divergences[branchName]
+
+	return d, ok
+}
+
+// Pull request templates at the base of the pull request.
+func (c *common) Templates(number int) []string {
+	c.templatesOnce.Do(func() {
+		c.templates = make(map[int][]string)
+		for _, pr := range c.em.PullRequests {
+			c.templates[pr.Number] = c.em.PullRequestTemplates(pr)
+		}
+	})
+
+	return c.templates[number]
+}
+
+// Short lived branch that was merged but not deleted.
+func (c *common) MergedBranch(branchName string) (enriched.MergedBranch, bool) {
+	c.mergedBranchesOnce.Do(func() {
+		c.mergedBranches = make(map[string]enriched.MergedBranch)
+		for _, mb := range c.em.MergedBranches() {
+			c.mergedBranches[mb.Branch] = mb
+		}
+	})
+
+	mb, ok := c.mergedBranches[branchName]
+
+	return mb, ok
+}
+
+// Create a common object from the enriched model, it is shared by the detectors run on the same model.
 func NewCommon(em *enriched.EnrichedModel) (*common, error) {
-	if commonMemo == nil {
-		var mergingCommits []local.Hash
-		currentPR, err := em.FindCurrentPR()
+	commonMemoMu.Lock()
+	defer commonMemoMu.Unlock()
+
+	if c, ok := commonMemo[em]; ok {
+		return c, nil
+	}
+
+	var mergingCommits []local.Hash
+	currentPR, err := em.FindCurrentPR()
+	if err != nil {
+		log.Warn("unable to find current PR")
+	} else {
+		mergingCommits, err = em.FindMergingCommits(currentPR)
 		if err != nil {
-			log.Warn("unable to find current PR")
-		} else {
-			mergingCommits, err = em.FindMergingCommits(currentPR)
-			if err != nil {
-				log.Warn("unable to find merging commits")
-			}
+			log.Warn("unable to find merging commits, falling back to linked pull request commits")
+			mergingCommits = em.CommitsForPullRequest(currentPR)
 		}
+	}
 
-		commonMemo = &common{
-			owner:          em.Owner,
-			repo:           em.Name,
-			PR:             currentPR,
-			mergingCommits: mergingCommits,
+	var primaryCommits map[local.Hash]struct{}
+	if em.Roles != nil {
+		primaryCommits = em.BranchCommits(em.Roles.Primary)
+	}
+
+	pullRequests := make(map[int]bool, len(em.PullRequests))
+	for _, pr := range em.PullRequests {
+		pullRequests[pr.Number] = true
+	}
+
+	var issues map[int]*remote.Issue
+	if len(em.Issues) > 0 {
+		issues = make(map[int]*remote.Issue, len(em.Issues))
+		for _, issue := range em.Issues {
+			issues[issue.Number] = issue
 		}
 	}
 
-	return commonMemo, nil
+	c := &common{
+		owner:          em.Owner,
+		repo:           em.Name,
+		PR:             currentPR,
+		mergingCommits: mergingCommits,
+		roles:          em.Roles,
+		primaryCommits: primaryCommits,
+		issues:         issues,
+		linkedIssues:   em.LinkedIssues(),
+		pullRequests:   pullRequests,
+		em:             em,
+	}
+	commonMemo[em] = c
+
+	return c, nil
+}
+
+// ReleaseCommon forgets the common object of the model once its detectors have run.
+func ReleaseCommon(em *enriched.EnrichedModel) {
+	commonMemoMu.Lock()
+	defer commonMemoMu.Unlock()
+
+	delete(commonMemo, em)
 }
 
 type Detector interface {
@@ -106,6 +237,12 @@ type Detector interface {
 	Name() string
 }
 
+// ConfigurableDetector is a detector that reads options from the config.
+type ConfigurableDetector interface {
+	Detector
+	Configure(cfg *config.Config) error
+}
+
 type CacheDetector interface {
 	Run(owner string, repo string, email string, current *cache.Cache, previous []*cache.Cache) error
 	Result() (violated, count, total int, violations []violation.Violation)
@@ -9,7 +9,7 @@ import (
 
 var ErrDevelopBranchModelNil = errors.New("develop branch model is nil")
 
-// DevelopBranch is a detector that checks if release and develop branch exist.
+// DevelopBranch is a detector that checks if a develop branch separate to the primary branch or a release branch exist.
 type DevelopBranch struct {
 	name       string
 	violated   int // non feature branches aka develop/release etc. (does not account default branch)
@@ -19,8 +19,8 @@ type DevelopBranch struct {
 }
 
 // NewDevelopBranch creates a new develop branch detector.
-func NewDevelopBranch(name string) *CherryPickDetector {
-	return &CherryPickDetector{
+func NewDevelopBranch(name string) *DevelopBranch {
+	return &DevelopBranch{
 		name:       name,
 		violated:   0,
 		found:      0,
@@ -39,7 +39,9 @@ func (db *DevelopBranch) Run(em *enriched.EnrichedModel) error {
 	db.total = 0
 	db.violations = make([]violation.Violation, 0)
 
-	if em.ReleaseGraph != nil && em.MainGraph != nil {
+	if em.Roles != nil && em.Roles.Develop != "" {
+		db.found = 1
+	} else if em.ReleaseGraph != nil && em.MainGraph != nil {
 		db.found = 1
 	}
 
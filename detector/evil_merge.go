@@ -0,0 +1,88 @@
+package detector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/violation"
+)
+
+// EvilMergeDetect finds merge commits adding or dropping lines that are in neither parent. Unrelated changes are
+// reported as evil merges, conflict resolutions are suggested for review. Each file is reported once per kind.
+func EvilMergeDetect() (string, CommitDetect) {
+	return "EvilMergeDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
+		if len(commit.ParentHashes) < 2 {
+			return false, nil, nil
+		}
+
+		changes, err := c.em.MergeChanges(commit)
+		if err != nil {
+			return false, nil, fmt.Errorf("could not find merge changes: %w", err)
+		}
+
+		var vs []violation.Violation
+		for _, change := range changes {
+			for _, conflict := range []bool{false, true} {
+				var hunks []local.MergeHunk
+				for _, h := range change.Hunks {
+					if h.Conflict == conflict {
+						hunks = append(hunks, h)
+					}
+				}
+
+				if len(hunks) == 0 {
+					continue
+				}
+
+				added, removed := 0, 0
+				for _, h := range hunks {
+					added += len(h.Added)
+					removed += len(h.Removed)
+				}
+
+				vs = append(vs, violation.NewEvilMergeViolation(
+					markup.Line{
+						File: markup.File{
+							Commit: markup.Commit{
+								Hash: commit.Hash.HexString(),
+								GitHubLink: markup.GitHubLink{
+									Owner: c.owner,
+									Repo:  c.repo,
+								},
+							},
+							Filepath: change.Name,
+						},
+						Start: hunks[0].Start,
+					},
+					hunkLines(hunks),
+					added,
+					removed,
+					conflict,
+					commit.Committer.Email,
+					commit.Committer.When,
+					c.IsCurrentCommit(commit.Hash),
+				))
+			}
+		}
+
+		return len(vs) > 0, vs, nil
+	}
+}
+
+// hunkLines formats the lines of the hunks in the merge result, eg: "2, 7-9". Hunks that only remove lines are
+// the line after the removed lines.
+func hunkLines(hunks []local.MergeHunk) string {
+	ranges := make([]string, len(hunks))
+	for i, h := range hunks {
+		if len(h.Added) <= 1 {
+			ranges[i] = fmt.Sprint(h.Start)
+
+			continue
+		}
+		ranges[i] = fmt.Sprintf("%d-%d", h.Start, h.Start+len(h.Added)-1)
+	}
+
+	return strings.Join(ranges, ", ")
+}
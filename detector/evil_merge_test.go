@@ -0,0 +1,83 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestEvilMergeDetect(t *testing.T) {
+	merge := local.Commit{
+		Hash:         local.Hash{3},
+		ParentHashes: []local.Hash{{1}, {2}},
+		MergeChanges: []local.MergeChange{
+			{
+				Name: "main.go",
+				Hunks: []local.MergeHunk{
+					{Start: 2, Added: []string{"EVIL"}},
+					{Start: 7, Added: []string{"A6", "B6"}, Removed: []string{"l6"}, Conflict: true},
+					{Start: 9, Removed: []string{"l8"}},
+					{Start: 12, Added: []string{"x", "y", "z"}},
+				},
+			},
+		},
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{merge, {Hash: local.Hash{2}}, {Hash: local.Hash{1}}},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	d := NewCommitDetector(EvilMergeDetect())
+	if err := d.Run(em); err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, total, violations := d.Result()
+	if found != 1 || total != 3 {
+		t.Errorf("Result() found = %d, total = %d, want 1, 3", found, total)
+	}
+
+	file := markup.File{
+		Commit: markup.Commit{
+			GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"},
+			Hash:       local.Hash{3}.HexString(),
+		},
+		Filepath: "main.go",
+	}
+	want := []string{
+		"Merge adds 4 and removes 1 lines that are in neither parent at " +
+			markup.Line{File: file, Start: 2}.Markdown() + ", lines 2, 9, 12-14",
+		"Merge resolves conflicts at " + markup.Line{File: file, Start: 7}.Markdown() +
+			", lines 7-8, adding 2 and removing 1 lines",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(violations), len(want))
+	}
+
+	for i, v := range violations {
+		if got := v.Message(); got != want[i] {
+			t.Errorf("Message() = %q, want %q", got, want[i])
+		}
+	}
+
+	// Later models are checked against their own merges and repository.
+	merge.MergeChanges = []local.MergeChange{
+		{Name: "main.go", Hunks: []local.MergeHunk{{Start: 2, Added: []string{"EVIL"}}}},
+	}
+	other := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{merge, {Hash: local.Hash{2}}, {Hash: local.Hash{1}}},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "other"})
+	if err := d.Run(other); err != nil {
+		t.Fatal(err)
+	}
+
+	file.Commit.GitHubLink.Repo = "other"
+	wantOther := "Merge adds 1 and removes 0 lines that are in neither parent at " +
+		markup.Line{File: file, Start: 2}.Markdown() + ", lines 2"
+	if _, _, _, violations = d.Result(); len(violations) != 1 || violations[0].Message() != wantOther {
+		t.Errorf("Result() of other model = %v, want %q", violations, wantOther)
+	}
+}
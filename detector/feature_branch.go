@@ -2,8 +2,11 @@ package detector
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
@@ -26,6 +29,9 @@ type FeatureBranchDetector struct {
 	violations []violation.Violation
 
 	primaryBranch string
+	expected      enriched.MergeStrategy // UnknownStrategy accepts any strategy.
+	backMerges    enriched.BackMergePolicy
+	em            *enriched.EnrichedModel
 }
 
 // NewFeatureBranchDetector creates a new feature branch detector.
@@ -41,6 +47,25 @@ func NewFeatureBranchDetector(name string) *FeatureBranchDetector {
 	}
 }
 
+// Configure reads the expected merge strategy from the config.
+func (bs *FeatureBranchDetector) Configure(cfg *config.Config) error {
+	expected, err := enriched.ParseMergeStrategy(cfg.MergeStrategy)
+	if err != nil {
+		return fmt.Errorf("failed to configure %s: %w", bs.name, err)
+	}
+
+	bs.expected = expected
+
+	backMerges, err := enriched.ParseBackMergePolicy(cfg.BackMerges)
+	if err != nil {
+		return fmt.Errorf("failed to configure %s: %w", bs.name, err)
+	}
+
+	bs.backMerges = backMerges
+
+	return nil
+}
+
 func (bs *FeatureBranchDetector) Run(em *enriched.EnrichedModel) error {
 	if em == nil {
 		return ErrFeatureBranchModelNil
@@ -57,12 +82,136 @@ func (bs *FeatureBranchDetector) Run(em *enriched.EnrichedModel) error {
 	}
 
 	bs.primaryBranch = em.MainGraph.BranchName
+	if em.Roles != nil {
+		bs.primaryBranch = em.Roles.Integration()
+	}
+	bs.em = em
 
 	bs.checkNext(c, em.MainGraph.Head)
+	bs.checkStrategies(c)
+	bs.checkBackMerges(c)
 
 	return nil
 }
 
+// checkStrategies reports pull requests into the primary branch merged with an unexpected strategy.
+func (bs *FeatureBranchDetector) checkStrategies(c *common) {
+	if c == nil || bs.expected == enriched.UnknownStrategy {
+		return
+	}
+
+	for _, pr := range bs.em.PullRequests {
+		if !pr.Merged || pr.BaseRefName != bs.primaryBranch {
+			continue
+		}
+
+		strategy := bs.em.MergeStrategy(pr)
+		if strategy == enriched.UnknownStrategy {
+			continue
+		}
+
+		bs.total++
+		if strategy == bs.expected {
+			continue
+		}
+
+		login := ""
+		if pr.MergedBy != nil {
+			login = pr.MergedBy.Login
+		}
+
+		mergedAt := time.Time{}
+		if pr.ClosedAt != nil {
+			mergedAt = *pr.ClosedAt
+		}
+
+		bs.violations = append(bs.violations, violation.NewMergeStrategyViolation(
+			markup.PR{
+				Number: pr.Number,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			strategy.String(),
+			bs.expected.String(),
+			login,
+			mergedAt,
+			c.IsCurrentPR(pr),
+		))
+		bs.violated++
+	}
+}
+
+// checkBackMerges reports merges of long lived branches into feature branches, following the back merge policy.
+func (bs *FeatureBranchDetector) checkBackMerges(c *common) {
+	if c == nil || bs.backMerges == enriched.AllowBackMerges {
+		return
+	}
+
+	severity := violation.Violated
+	if bs.backMerges == enriched.SuggestBackMerges {
+		severity = violation.Suggestion
+	}
+
+	commits := make(map[local.Hash]*local.Commit, len(bs.em.Commits))
+	for i := range bs.em.Commits {
+		commits[bs.em.Commits[i].Hash] = &bs.em.Commits[i]
+	}
+
+	for _, m := range bs.em.Merges() {
+		if m.Direction == enriched.UnknownDirection {
+			continue
+		}
+
+		bs.total++
+		if m.Direction != enriched.BackMerge {
+			continue
+		}
+
+		commit := commits[m.Commit]
+		bs.violations = append(bs.violations, violation.NewBackMergeViolation(
+			markup.Commit{
+				Hash: m.Commit.HexString(),
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			m.Source,
+			m.Target,
+			severity,
+			commit.Committer.Email,
+			commit.Committer.When,
+			c.IsCurrentCommit(m.Commit),
+		))
+
+		if severity == violation.Violated {
+			bs.violated++
+		}
+	}
+}
+
+// mergedByPullRequest checks if a single parent commit was squash or rebase merged by a pull request.
+func (bs *FeatureBranchDetector) mergedByPullRequest(hash string) bool {
+	if bs.em == nil {
+		return false
+	}
+
+	h, err := local.NewHash(hash)
+	if err != nil {
+		return false
+	}
+
+	for _, l := range bs.em.CommitPullRequests[h] {
+		if l.Reason == enriched.LinkMergeCommit || l.Reason == enriched.LinkRebased {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (bs *FeatureBranchDetector) Result() (int, int, int, []violation.Violation) {
 	return bs.violated, bs.found, bs.total, bs.violations
 }
@@ -136,6 +285,13 @@ func (bs *FeatureBranchDetector) checkNext(c *common, cg *local.CommitGraph) *lo
 	}
 	bs.violated += len(v)
 
+	// only one parent but squash or rebase merged from a pull request
+	if bs.mergedByPullRequest(cg.Hash) {
+		bs.found++
+
+		return bs.checkNext(c, cg.ParentCommits[0])
+	}
+
 	// only one parent (violation)
 	bs.violations = append(bs.violations,
 		violation.NewPrimaryBranchDirectCommitViolation(
@@ -191,6 +347,11 @@ func (bs *FeatureBranchDetector) checkEnd(
 		return cg, v
 	}
 
+	// The parent has one commit, squash or rebase merged from a pull request
+	if bs.mergedByPullRequest(cg.Hash) {
+		return bs.checkEnd(c, cg.ParentCommits[0], v)
+	}
+
 	// The parent has one commit
 	v = append(v, violation.NewPrimaryBranchDirectCommitViolation(
 		markup.Branch{
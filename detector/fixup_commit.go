@@ -0,0 +1,169 @@
+package detector
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrFixupCommitModelNil = errors.New("fixup commit model is nil")
+
+// fixupDelimiters end fixup markers that are words, eg: "WIP: login page" or "[WIP] login page".
+const fixupDelimiters = ":!])"
+
+// FixupCommitDetector finds fixup, squash and work in progress commits that reached the primary branch
+// through its first parent history or a merged pull request.
+type FixupCommitDetector struct {
+	name       string
+	violated   int // marked commits
+	found      int // clean commits
+	total      int // commits on the primary branch or merged by pull requests
+	violations []violation.Violation
+
+	params config.FixupCommitParameters
+}
+
+// NewFixupCommitDetector creates a new fixup commit detector.
+func NewFixupCommitDetector(name string, params config.FixupCommitParameters) *FixupCommitDetector {
+	return &FixupCommitDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+	}
+}
+
+func (fc *FixupCommitDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrFixupCommitModelNil
+	}
+
+	fc.violated = 0
+	fc.found = 0
+	fc.total = 0
+	fc.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	merged := make(map[local.Hash]struct{})
+	for _, h := range em.FirstParentHistory() {
+		merged[h] = struct{}{}
+	}
+
+	for i := range em.Commits {
+		commit := &em.Commits[i]
+
+		pr := mergedBy(em, commit.Hash)
+		if _, ok := merged[commit.Hash]; !ok && pr == nil {
+			continue
+		}
+
+		fc.total++
+		marker, ok := fixupMarker(commit.Message, fc.params.Markers)
+		if !ok {
+			fc.found++
+
+			continue
+		}
+		fc.violated++
+
+		var prLink *markup.PR
+		if pr != nil {
+			prLink = &markup.PR{
+				Number: pr.Number,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			}
+		}
+
+		fc.violations = append(fc.violations, violation.NewFixupCommitViolation(
+			markup.Commit{
+				Hash: commit.Hash.HexString(),
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			commit.Message,
+			marker,
+			prLink,
+			commit.Committer.Email,
+			commit.Committer.When,
+			c.IsCurrentCommit(commit.Hash),
+		))
+	}
+
+	return nil
+}
+
+func (fc *FixupCommitDetector) Result() (int, int, int, []violation.Violation) {
+	return fc.violated, fc.found, fc.total, fc.violations
+}
+
+func (fc *FixupCommitDetector) Name() string {
+	return fc.name
+}
+
+// mergedBy finds the merged pull request that brought the commit into the primary or integration branch.
+// Commits of squash merged pull requests never reach the base branch.
+func mergedBy(em *enriched.EnrichedModel, h local.Hash) *remote.PullRequest {
+	for _, link := range em.CommitPullRequests[h] {
+		if !link.PullRequest.Merged {
+			continue
+		}
+
+		// Pull requests into other branches, eg: a feature branch, can still be cleaned before reaching them.
+		base := link.PullRequest.BaseRefName
+		if em.Roles != nil && base != em.Roles.Primary && base != em.Roles.Integration() {
+			continue
+		}
+
+		squashed := em.MergeStrategy(link.PullRequest) == enriched.SquashStrategy
+		if link.Reason == enriched.LinkPullRequestCommit && squashed {
+			continue
+		}
+
+		return link.PullRequest
+	}
+
+	return nil
+}
+
+// fixupMarker finds the marker the commit subject starts with, eg: "fixup! Add login" or "WIP: login page".
+// Markers ending in a word are the whole subject or followed by a delimiter, so "temp" does not match
+// "Temp fix for login timeout" or "tmp" match "tmpfs support".
+func fixupMarker(message string, markers []string) (string, bool) {
+	subject := strings.TrimLeft(strings.SplitN(strings.TrimSpace(message), "\n", 2)[0], "[( ")
+	lower := strings.ToLower(subject)
+
+	for _, marker := range markers {
+		m := strings.ToLower(marker)
+		if !strings.HasPrefix(lower, m) {
+			continue
+		}
+
+		rest := strings.TrimLeft(lower[len(m):], " ")
+		last := []rune(m)[len([]rune(m))-1]
+		word := unicode.IsLetter(last) || unicode.IsDigit(last)
+		if !word || rest == "" || strings.ContainsRune(fixupDelimiters, []rune(rest)[0]) {
+			return marker, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,135 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestFixupCommitDetector(t *testing.T) {
+	commit := func(hash byte, message string, parents ...byte) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}, Message: message}
+		for _, p := range parents {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{p})
+		}
+
+		return c
+	}
+	node := func(hash byte, parents ...*local.CommitGraph) *local.CommitGraph {
+		return &local.CommitGraph{Hash: local.Hash{hash}.HexString(), ParentCommits: parents}
+	}
+
+	// 1 - 2 ----- 4 - 5 - 7   main
+	//      \     /
+	//       --- 3             feature, merged by #1
+	// 6 is squashed into 7 by #2.
+	// 10 - 8 - 9              spike, 8 merged into experiment by #3.
+	commits := []local.Commit{
+		commit(10, "Start experiment"),
+		commit(9, "Merge pull request #3 from spike", 10, 8),
+		commit(8, "WIP: experiment", 10),
+		commit(7, "Add signup (#2)", 5),
+		commit(6, "wip"),
+		commit(5, "Add tmpfs support", 4),
+		commit(4, "Merge pull request #1 from feature", 2, 3),
+		commit(3, "WIP: login page", 2),
+		commit(2, "fixup! Initial commit", 1),
+		commit(1, "Initial commit"),
+	}
+	two := node(2, node(1))
+	head := node(7, node(5, node(4, two, node(3, two))))
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits:   commits,
+		MainGraph: &local.BranchGraph{BranchName: "main", Head: head},
+	}, remote.RemoteModel{
+		Owner: "Git-Gopher",
+		Name:  "tests",
+		PullRequests: []*remote.PullRequest{
+			{
+				Number:      1,
+				Merged:      true,
+				BaseRefName: "main",
+				MergeCommit: local.Hash{4}.HexString(),
+				Commits:     []*remote.PullRequestCommit{{Oid: local.Hash{3}.HexString()}},
+			},
+			{
+				Number:      2,
+				Merged:      true,
+				BaseRefName: "main",
+				MergeCommit: local.Hash{7}.HexString(),
+				Commits:     []*remote.PullRequestCommit{{Oid: local.Hash{6}.HexString(), MessageHeadline: "wip"}},
+			},
+			{
+				Number:      3,
+				Merged:      true,
+				BaseRefName: "experiment",
+				MergeCommit: local.Hash{9}.HexString(),
+				Commits:     []*remote.PullRequestCommit{{Oid: local.Hash{8}.HexString()}},
+			},
+		},
+	})
+	em.Roles = &enriched.BranchRoles{Primary: "main"}
+
+	d := NewFixupCommitDetector("FixupCommitDetector", config.DefaultParameters().FixupCommitDetector)
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	violated, found, total, violations := d.Result()
+	if violated != 2 || found != 4 || total != 6 {
+		t.Errorf("Result() = %d, %d, %d, want 2, 4, 6", violated, found, total)
+	}
+
+	link := func(hash byte) string {
+		return markup.Commit{
+			Hash:       local.Hash{hash}.HexString(),
+			GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"},
+		}.Markdown()
+	}
+
+	want := []string{
+		"Commit \"WIP: login page\" on " + link(3) + " marked \"wip\" was merged by " +
+			"[#1](https://github.com/Git-Gopher/tests/pull/1) without cleaning the history",
+		"Commit \"fixup! Initial commit\" on " + link(2) + " marked \"fixup!\" was committed to the primary branch",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("violations = %d, want %d", len(violations), len(want))
+	}
+	for i, v := range violations {
+		if v.Message() != want[i] {
+			t.Errorf("Message() = %s, want %s", v.Message(), want[i])
+		}
+	}
+}
+
+func TestFixupMarker(t *testing.T) {
+	markers := config.DefaultParameters().FixupCommitDetector.Markers
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"fixup! Add login", "fixup!"},
+		{"squash!Add login", "squash!"},
+		{"[WIP] login page", "wip"},
+		{"WIP : login page", "wip"},
+		{"tmp", "tmp"},
+		{"Temp fix for login timeout", ""},
+		{"wip login page", ""},
+		{"asdf\n\nbody", "asdf"},
+		{"Wipe the cache", ""},
+		{"Add tmpfs support", ""},
+		{"temporary fix", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if got, _ := fixupMarker(tt.message, markers); got != tt.want {
+				t.Errorf("fixupMarker() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestFormattingCommitDetect(t *testing.T) {
+	commit := func(hash byte, parents int, diffs ...local.Diff) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}, DiffToParents: diffs}
+		for i := 0; i < parents; i++ {
+			c.ParentHashes = append(c.ParentHashes, local.Hash{0})
+		}
+
+		return c
+	}
+	crlf := local.Diff{Name: "a.txt", Deletion: "a\r\n", Addition: "a\n"}
+	mode := local.Diff{Name: "run.sh", ModeChanged: true}
+	content := local.Diff{Name: "main.go", Addition: "package main\n"}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(1, 0, content),
+			commit(2, 1, crlf, crlf),
+			commit(3, 1, mode),
+			commit(4, 1, crlf, content),
+			commit(5, 2, crlf),
+		},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	d := NewCommitDetector(FormattingCommitDetect())
+	if err := d.Run(em); err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, total, violations := d.Result()
+	if found != 2 || total != 5 {
+		t.Errorf("Result() found = %d, total = %d, want 2, 5", found, total)
+	}
+
+	link := markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"}
+	want := []string{
+		"Commit " + markup.Commit{GitHubLink: link, Hash: local.Hash{2}.HexString()}.Markdown() +
+			" only changes the line endings of 2 files",
+		"Commit " + markup.Commit{GitHubLink: link, Hash: local.Hash{3}.HexString()}.Markdown() +
+			" only changes the file modes of 1 files",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(violations), len(want))
+	}
+
+	for i, v := range violations {
+		if got := v.Message(); got != want[i] {
+			t.Errorf("Message() = %q, want %q", got, want[i])
+		}
+	}
+}
@@ -11,23 +11,25 @@ import (
 
 var ErrHotfixModelNil = errors.New("hotfix model is nil")
 
-// HotfixDetector that finds number of hotfixes using tag number.
+// HotfixDetector that finds number of hotfixes using tag number, and counts merged hotfix branches separately.
 type HotfixDetector struct {
-	name       string
-	violated   int // non feature branches aka develop/release etc. (does not account default branch)
-	found      int // total feature branches
-	total      int // total branches
-	violations []violation.Violation
+	name         string
+	violated     int // non feature branches aka develop/release etc. (does not account default branch)
+	found        int // total feature branches
+	total        int // total branches
+	pullRequests int // merged pull requests from hotfix branches
+	violations   []violation.Violation
 }
 
 // NewHotfixDetector creates a new hotfix detector.
 func NewHotfixDetector(name string) *HotfixDetector {
 	return &HotfixDetector{
-		name:       name,
-		violated:   0,
-		found:      0,
-		total:      0,
-		violations: make([]violation.Violation, 0),
+		name:         name,
+		violated:     0,
+		found:        0,
+		total:        0,
+		pullRequests: 0,
+		violations:   make([]violation.Violation, 0),
 	}
 }
 
@@ -36,6 +38,23 @@ func (cp *HotfixDetector) Run(em *enriched.EnrichedModel) error {
 		return ErrHotfixModelNil
 	}
 
+	cp.violated = 0
+	cp.found = 0
+	cp.total = 0
+	cp.pullRequests = 0
+	cp.violations = make([]violation.Violation, 0)
+
+	// Merged pull requests from branches with the hotfix role.
+	for _, pr := range em.PullRequests {
+		if !pr.Merged {
+			continue
+		}
+
+		if em.Roles.Role(pr.HeadRefName) == enriched.HotfixRole {
+			cp.pullRequests++
+		}
+	}
+
 	sortedTags := em.Tags
 
 	sort.Slice(sortedTags, func(i, j int) bool {
@@ -74,6 +93,12 @@ func (cp *HotfixDetector) Result() (int, int, int, []violation.Violation) {
 	return cp.violated, cp.found, cp.total, cp.violations
 }
 
+// PullRequests is the number of merged pull requests from hotfix branches, which are not tags so are not in the
+// result.
+func (cp *HotfixDetector) PullRequests() int {
+	return cp.pullRequests
+}
+
 func (cp *HotfixDetector) Name() string {
 	return cp.name
 }
@@ -0,0 +1,226 @@
+package detector
+
+import (
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+type IssueDetect func(c *common, issue *remote.Issue) (bool, violation.Violation, error)
+
+// IssueDetector is used to run a detector on each issue of the repository.
+type IssueDetector struct {
+	name       string
+	violated   int
+	found      int
+	total      int
+	violations []violation.Violation
+
+	detect IssueDetect
+}
+
+func NewIssueDetector(name string, detect IssueDetect) *IssueDetector {
+	return &IssueDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		detect:     detect,
+	}
+}
+
+func (id *IssueDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return nil
+	}
+
+	id.violated = 0
+	id.found = 0
+	id.total = 0
+	id.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	for _, issue := range em.Issues {
+		detected, violation, err := id.detect(c, issue)
+		id.total++
+		if err != nil {
+			return err
+		}
+		if detected {
+			id.found++
+		}
+		if violation != nil {
+			id.violations = append(id.violations, violation)
+		}
+	}
+
+	return nil
+}
+
+func (id *IssueDetector) Result() (int, int, int, []violation.Violation) {
+	return id.violated, id.found, id.total, id.violations
+}
+
+func (id *IssueDetector) Name() string {
+	return id.name
+}
+
+// IssueClosedWithoutLinkDetector finds issues closed as completed without a commit or pull request that resolved them.
+func IssueClosedWithoutLinkDetector() (string, IssueDetect) {
+	return "IssueClosedWithoutLinkDetector", func(c *common, issue *remote.Issue) (bool, violation.Violation, error) {
+		if issue.State != "CLOSED" || !completed(issue) || c.linkedIssues[issue.Number] {
+			return false, nil, nil
+		}
+
+		return true, violation.NewIssueClosedWithoutLinkViolation(
+			markupIssue(c, issue),
+			issueOwner(issue),
+			issueTime(issue.ClosedAt, issue.UpdatedAt, issue.CreatedAt),
+			c.IsCurrentIssue(issue.Number),
+		), nil
+	}
+}
+
+// StaleIssueDetector finds open issues without activity, which are either done, abandoned or forgotten.
+func StaleIssueDetector(params config.StaleIssueParameters) (string, IssueDetect) {
+	staleIssueTime := time.Hour * 24 * time.Duration(params.Days)
+
+	return "StaleIssueDetector", func(c *common, issue *remote.Issue) (bool, violation.Violation, error) {
+		updated := issueTime(issue.UpdatedAt, issue.CreatedAt)
+		if issue.State != "OPEN" || updated.IsZero() || time.Since(updated) <= staleIssueTime {
+			return false, nil, nil
+		}
+
+		return true, violation.NewStaleIssueViolation(
+			markupIssue(c, issue),
+			time.Since(updated),
+			issueOwner(issue),
+			updated,
+			c.IsCurrentIssue(issue.Number),
+		), nil
+	}
+}
+
+// IssueTriageDetector finds issues without labels or assignees. Issues closed as not planned or duplicates
+// were never worked on, so they do not need either.
+func IssueTriageDetector() (string, IssueDetect) {
+	return "IssueTriageDetector", func(c *common, issue *remote.Issue) (bool, violation.Violation, error) {
+		unlabeled, unassigned := len(issue.Labels) == 0, len(issue.Assignees) == 0
+		if !completed(issue) || !unlabeled && !unassigned {
+			return false, nil, nil
+		}
+
+		login := ""
+		if issue.Author != nil {
+			login = issue.Author.Login
+		}
+
+		return true, violation.NewIssueTriageViolation(
+			markupIssue(c, issue),
+			unlabeled,
+			unassigned,
+			login,
+			issueTime(issue.CreatedAt),
+			c.IsCurrentIssue(issue.Number),
+		), nil
+	}
+}
+
+// CommitIssueReferenceDetect finds commit messages referencing issues that do not exist, or were already closed
+// when the commit was made. Pull request numbers are valid references, references are only checked when issues
+// were scraped.
+func CommitIssueReferenceDetect() (string, CommitDetect) {
+	return "CommitIssueReferenceDetect", func(c *common, commit *local.Commit) (bool, []violation.Violation, error) {
+		if c.issues == nil {
+			return true, nil, nil
+		}
+
+		var vs []violation.Violation
+		for _, n := range enriched.IssueReferences(commit.Message) {
+			issue, exists := c.issues[n]
+			closed := exists && issue.State == "CLOSED" && issue.ClosedAt != nil &&
+				issue.ClosedAt.Before(commit.Committer.When)
+			if c.pullRequests[n] || exists && !closed {
+				continue
+			}
+
+			var closedAt *time.Time
+			if closed {
+				closedAt = issue.ClosedAt
+			}
+
+			vs = append(vs, violation.NewCommitIssueReferenceViolation(
+				markup.Commit{
+					Hash: commit.Hash.HexString(),
+					GitHubLink: markup.GitHubLink{
+						Owner: c.owner,
+						Repo:  c.repo,
+					},
+				},
+				markup.Issue{
+					Number: n,
+					GitHubLink: markup.GitHubLink{
+						Owner: c.owner,
+						Repo:  c.repo,
+					},
+				},
+				closedAt,
+				commit.Committer.Email,
+				commit.Committer.When,
+				c.IsCurrentCommit(commit.Hash),
+			))
+		}
+
+		return len(vs) == 0, vs, nil
+	}
+}
+
+// completed checks the issue was not closed as not planned or as a duplicate.
+func completed(issue *remote.Issue) bool {
+	return issue.StateReason != "NOT_PLANNED" && issue.StateReason != "DUPLICATE"
+}
+
+// issueOwner is the login responsible for an issue, the first assignee or otherwise the author.
+func issueOwner(issue *remote.Issue) string {
+	if len(issue.Assignees) != 0 {
+		return issue.Assignees[0]
+	}
+
+	if issue.Author != nil {
+		return issue.Author.Login
+	}
+
+	return ""
+}
+
+// issueTime is the first known time, issues of archives exported before times were scraped have none.
+func issueTime(times ...*time.Time) time.Time {
+	for _, t := range times {
+		if t != nil {
+			return *t
+		}
+	}
+
+	return time.Time{}
+}
+
+func markupIssue(c *common, issue *remote.Issue) markup.Issue {
+	return markup.Issue{
+		Number: issue.Number,
+		GitHubLink: markup.GitHubLink{
+			Owner: c.owner,
+			Repo:  c.repo,
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package detector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestIssueDetectors(t *testing.T) {
+	daysAgo := func(days int) *time.Time {
+		when := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+
+		return &when
+	}
+	commit := func(hash byte, message string, days int) local.Commit {
+		c := local.Commit{Hash: local.Hash{hash}, Message: message}
+		c.Committer.When = *daysAgo(days)
+
+		return c
+	}
+	issue := func(number int, state string, updated int) *remote.Issue {
+		return &remote.Issue{
+			Number:    number,
+			State:     state,
+			Author:    &remote.Author{Login: "gopher"},
+			CreatedAt: daysAgo(90),
+			UpdatedAt: daysAgo(updated),
+			Labels:    []string{"bug"},
+			Assignees: []string{"gopher"},
+		}
+	}
+
+	fixed := issue(1, "CLOSED", 20)
+	fixed.ClosedAt = daysAgo(20)
+	closed := issue(2, "CLOSED", 10)
+	closed.ClosedAt = daysAgo(10)
+	dropped := issue(3, "CLOSED", 10)
+	dropped.StateReason, dropped.Labels, dropped.Assignees = "NOT_PLANNED", nil, nil
+	stale := issue(4, "OPEN", 60)
+	untriaged := issue(5, "OPEN", 1)
+	untriaged.Labels = nil
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(4, "Tidy login, see #1", 5),
+			commit(3, "Merge pull request #6 from Git-Gopher/signup", 15),
+			commit(2, "Add signup for #42", 15),
+			commit(1, "Fix login\n\nFixes #1", 21),
+		},
+	}, remote.RemoteModel{
+		Owner:        "Git-Gopher",
+		Name:         "tests",
+		Issues:       []*remote.Issue{fixed, closed, dropped, stale, untriaged},
+		PullRequests: []*remote.PullRequest{{Number: 6}},
+	})
+
+	issueLink := func(n int) string {
+		return markup.Issue{Number: n, GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"}}.Markdown()
+	}
+	commitLink := func(hash byte) string {
+		return markup.Commit{
+			Hash:       local.Hash{hash}.HexString(),
+			GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"},
+		}.Markdown()
+	}
+
+	tests := []struct {
+		name     string
+		detector Detector
+		want     []string
+	}{
+		{
+			"closed_without_link",
+			NewIssueDetector(IssueClosedWithoutLinkDetector()),
+			[]string{"Issue " + issueLink(2) + " was closed without a linked pull request or commit"},
+		},
+		{
+			"stale",
+			NewIssueDetector(StaleIssueDetector(config.DefaultParameters().StaleIssueDetector)),
+			[]string{"Issue " + issueLink(4) + " has had no activity for 60 days"},
+		},
+		{
+			"triage",
+			NewIssueDetector(IssueTriageDetector()),
+			[]string{"Issue " + issueLink(5) + " has no labels"},
+		},
+		{
+			"commit_reference",
+			NewCommitDetector(CommitIssueReferenceDetect()),
+			[]string{
+				"Commit " + commitLink(4) + " references issue " + issueLink(1) + ", which was closed on " +
+					fixed.ClosedAt.Format("2006-01-02") + " before the commit was made",
+				"Commit " + commitLink(2) + " references issue #42, which does not exist",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.detector.Run(em); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			_, _, _, violations := tt.detector.Result()
+			if len(violations) != len(tt.want) {
+				t.Fatalf("violations = %d, want %d", len(violations), len(tt.want))
+			}
+			for i, v := range violations {
+				if v.Message() != tt.want[i] {
+					t.Errorf("Message() = %s, want %s", v.Message(), tt.want[i])
+				}
+			}
+		})
+	}
+}
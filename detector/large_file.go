@@ -0,0 +1,165 @@
+package detector
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrLargeFileModelNil = errors.New("large file model is nil")
+
+// LargeFileDetector finds files committed above the size limit without Git LFS,
+// and the repository bloat they add per author.
+type LargeFileDetector struct {
+	name       string
+	violated   int // large file versions
+	found      int // file versions within the limit or stored with LFS
+	total      int // total file versions
+	violations []violation.Violation
+
+	params config.LargeFileParameters
+}
+
+// NewLargeFileDetector creates a new large file detector.
+func NewLargeFileDetector(name string, params config.LargeFileParameters) *LargeFileDetector {
+	return &LargeFileDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+	}
+}
+
+// bloat is the size of large files added by an author.
+type bloat struct {
+	size    int64
+	files   int
+	last    time.Time
+	current bool
+}
+
+func (lf *LargeFileDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrLargeFileModelNil
+	}
+
+	lf.violated = 0
+	lf.found = 0
+	lf.total = 0
+	lf.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	maxSize := lf.params.MaxSizeKB * 1024
+	authors := make(map[string]*bloat)
+	reported := make(map[string]bool)
+
+	// Oldest first so files are reported by the commit that introduced them.
+	commits := make([]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[i] = &em.Commits[i]
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Author.When.Before(commits[j].Author.When)
+	})
+
+	for _, commit := range commits {
+		// Merge commits repeat the changes of the merged branch.
+		if len(commit.ParentHashes) > 1 {
+			continue
+		}
+
+		for _, diff := range commit.DiffToParents {
+			// Deleted files and files that were not changed do not add a blob.
+			if diff.Size == 0 || (diff.Addition == "" && !diff.IsBinary) {
+				continue
+			}
+
+			lf.total++
+			if diff.IsLFSPointer || diff.Size <= maxSize {
+				lf.found++
+
+				continue
+			}
+			lf.violated++
+
+			current := c.IsCurrentCommit(commit.Hash)
+			b, ok := authors[commit.Author.Email]
+			if !ok {
+				b = &bloat{}
+				authors[commit.Author.Email] = b
+			}
+			b.size += diff.Size
+			b.files++
+			b.current = b.current || current
+			if commit.Author.When.After(b.last) {
+				b.last = commit.Author.When
+			}
+
+			// Each version of a file is bloat, but the file is only reported once.
+			if reported[diff.Name] {
+				continue
+			}
+			reported[diff.Name] = true
+
+			lf.violations = append(lf.violations, violation.NewLargeFileViolation(
+				markup.File{
+					Commit: markup.Commit{
+						Hash: commit.Hash.HexString(),
+						GitHubLink: markup.GitHubLink{
+							Owner: c.owner,
+							Repo:  c.repo,
+						},
+					},
+					Filepath: diff.Name,
+				},
+				diff.Size,
+				maxSize,
+				diff.IsBinary,
+				local.MatchesLFSPattern(diff.Name, em.LFSPatterns),
+				commit.Author.Email,
+				commit.Author.When,
+				current,
+			))
+		}
+	}
+
+	emails := make([]string, 0, len(authors))
+	for email := range authors {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	for _, email := range emails {
+		b := authors[email]
+		lf.violations = append(lf.violations, violation.NewRepositoryBloatViolation(
+			b.size,
+			b.files,
+			email,
+			b.last,
+			b.current,
+		))
+	}
+
+	return nil
+}
+
+func (lf *LargeFileDetector) Result() (int, int, int, []violation.Violation) {
+	return lf.violated, lf.found, lf.total, lf.violations
+}
+
+func (lf *LargeFileDetector) Name() string {
+	return lf.name
+}
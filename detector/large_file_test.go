@@ -0,0 +1,67 @@
+package detector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestLargeFileDetector(t *testing.T) {
+	when := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	commit := func(hash byte, email string, diffs ...local.Diff) local.Commit {
+		return local.Commit{
+			Hash:          local.Hash{hash},
+			ParentHashes:  []local.Hash{{hash - 1}},
+			Author:        local.Signature{Email: email, When: when.Add(time.Duration(hash) * time.Hour)},
+			DiffToParents: diffs,
+		}
+	}
+
+	const mb = 1024 * 1024
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(4, "gopher@example.com", local.Diff{Name: "logo.psd", IsBinary: true, Size: 3 * mb}),
+			commit(3, "gopher@example.com", local.Diff{Name: "video.mp4", IsBinary: true, Size: 128, IsLFSPointer: true}),
+			commit(2, "gopher@example.com", local.Diff{Name: "logo.psd", IsBinary: true, Size: 2 * mb}),
+			commit(1, "other@example.com",
+				local.Diff{Name: "data.csv", Addition: "a,b\n", Size: 5 * mb},
+				local.Diff{Name: "main.go", Addition: "package main\n", Size: 13},
+			),
+		},
+		LFSPatterns: []string{"*.psd"},
+	}, remote.RemoteModel{Owner: "Git-Gopher", Name: "tests"})
+
+	d := NewLargeFileDetector("LargeFileDetector", config.DefaultParameters().LargeFileDetector)
+	if err := d.Run(em); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	violated, found, total, violations := d.Result()
+	if violated != 3 || found != 2 || total != 5 {
+		t.Errorf("Result() = %d, %d, %d, want 3, 2, 5", violated, found, total)
+	}
+
+	// logo.psd is reported once, and both versions count towards the bloat of the author.
+	want := []string{"LargeFileViolation", "LargeFileViolation", "RepositoryBloatViolation", "RepositoryBloatViolation"}
+	if len(violations) != len(want) {
+		t.Fatalf("violations = %d, want %d", len(violations), len(want))
+	}
+	for i, v := range violations {
+		if v.Name() != want[i] {
+			t.Errorf("violations[%d] = %s, want %s", i, v.Name(), want[i])
+		}
+	}
+
+	if msg := violations[2].Message(); msg != "5.0 MB of large files were added to the history across 2 file versions" {
+		t.Errorf("Message() = %s", msg)
+	}
+
+	if suggestion, _ := violations[1].Suggestion(); !strings.Contains(suggestion, "committed without LFS") {
+		t.Errorf("Suggestion() = %s, want LFS migration", suggestion)
+	}
+}
@@ -1,9 +1,15 @@
 package detector
 
 import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/prtemplate"
 	"github.com/Git-Gopher/go-gopher/violation"
 	log "github.com/sirupsen/logrus"
 )
@@ -151,3 +157,289 @@ func PullRequestReviewThreadDetector() (string, PullRequestDetect) {
 		return false, nil, nil
 	}
 }
+
+// PullRequestSizeDetector finds pull requests with too many changed lines, files or commits to review well.
+// Generated files are not counted.
+func PullRequestSizeDetector(params config.PullRequestSizeParameters) (string, PullRequestDetect) {
+	return "PullRequestSizeDetector", func(c *common, pr *remote.PullRequest) (bool, violation.Violation, error) {
+		// Closed pull requests that were not merged were never integrated.
+		if pr.Closed && !pr.Merged {
+			return false, nil, nil
+		}
+
+		lines, files := pullRequestSize(pr, params.GeneratedPaths)
+		commits := len(pr.Commits)
+
+		var exceeded []string
+		if lines > params.MaxLines {
+			exceeded = append(exceeded, fmt.Sprintf("%d lines", params.MaxLines))
+		}
+		if files > params.MaxFiles {
+			exceeded = append(exceeded, fmt.Sprintf("%d files", params.MaxFiles))
+		}
+		if commits > params.MaxCommits {
+			exceeded = append(exceeded, fmt.Sprintf("%d commits", params.MaxCommits))
+		}
+
+		if len(exceeded) == 0 {
+			return false, nil, nil
+		}
+
+		if pr.CreatedAt == nil {
+			return false, nil, violation.ErrCreatedTimePullRequest
+		}
+
+		return true, violation.NewPullRequestSizeViolation(
+			markup.PR{
+				Number: pr.Number,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			lines,
+			files,
+			commits,
+			exceeded,
+			c.IsCurrentPR(pr),
+			*pr.CreatedAt,
+			pr.Author.Login,
+		), nil
+	}
+}
+
+// pullRequestSize counts the lines added and deleted and the files changed, excluding generated files.
+// Pull requests without the changed files use the totals reported by GitHub.
+func pullRequestSize(pr *remote.PullRequest, generated []string) (int, int) {
+	if len(pr.Files) == 0 {
+		return pr.Additions + pr.Deletions, pr.ChangedFiles
+	}
+
+	lines, files := 0, 0
+	for _, f := range pr.Files {
+		if matchPath(f.Path, generated) {
+			continue
+		}
+
+		lines += f.Additions + f.Deletions
+		files++
+	}
+
+	return lines, files
+}
+
+// matchPath checks if a file path matches any of the glob patterns. Patterns ending with a slash match
+// directories anywhere in the path, patterns with a slash match the full path and others match the base name.
+func matchPath(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		switch {
+		case strings.HasSuffix(pattern, "/"):
+			dir := strings.TrimSuffix(pattern, "/")
+			segments := strings.Split(name, "/")
+			for _, segment := range segments[:len(segments)-1] {
+				if ok, err := path.Match(dir, segment); err == nil && ok {
+					return true
+				}
+			}
+		case strings.Contains(pattern, "/"):
+			if ok, err := path.Match(strings.TrimPrefix(pattern, "/"), name); err == nil && ok {
+				return true
+			}
+		default:
+			if ok, err := path.Match(pattern, path.Base(name)); err == nil && ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PullRequestRubberStampDetector finds pull requests approved faster than their changes could have been read.
+// Generated files are not counted towards the size.
+func PullRequestRubberStampDetector(
+	params config.PullRequestRubberStampParameters,
+	generated []string,
+) (string, PullRequestDetect) {
+	return "PullRequestRubberStampDetector", func(c *common, pr *remote.PullRequest) (bool, violation.Violation, error) {
+		if pr.CreatedAt == nil {
+			return false, nil, violation.ErrCreatedTimePullRequest
+		}
+
+		lines, _ := pullRequestSize(pr, generated)
+		if lines < params.MinLines {
+			return false, nil, nil
+		}
+
+		approval := firstApproval(pr)
+		if approval == nil {
+			return false, nil, nil
+		}
+
+		reviewTime := approval.SubmittedAt.Sub(*pr.CreatedAt)
+		if reviewTime.Minutes()*float64(params.MaxLinesPerMinute) >= float64(lines) {
+			return false, nil, nil
+		}
+
+		return true, violation.NewRubberStampViolation(
+			markupPR(c, pr),
+			lines,
+			reviewTime,
+			approval.Author.Login,
+			c.IsCurrentPR(pr),
+			*approval.SubmittedAt,
+			approval.Author.Login,
+		), nil
+	}
+}
+
+// PullRequestSelfMergeDetector finds pull requests merged by their author without approval from someone else.
+func PullRequestSelfMergeDetector() (string, PullRequestDetect) {
+	return "PullRequestSelfMergeDetector", func(c *common, pr *remote.PullRequest) (bool, violation.Violation, error) {
+		if !pr.Merged || pr.MergedBy == nil || pr.Author == nil || pr.MergedBy.Login == "" {
+			return false, nil, nil
+		}
+
+		if pr.MergedBy.Login != pr.Author.Login || firstApproval(pr) != nil {
+			return false, nil, nil
+		}
+
+		// Pull request must have closed time if merged.
+		if pr.ClosedAt == nil {
+			return false, nil, violation.ErrClosedTimePullRequest
+		}
+
+		return true, violation.NewSelfMergeViolation(
+			markupPR(c, pr),
+			c.IsCurrentPR(pr),
+			*pr.ClosedAt,
+			pr.MergedBy.Login,
+		), nil
+	}
+}
+
+// PullRequestStaleApprovalDetector finds merged pull requests whose final head commit was never approved,
+// as commits were pushed after the last approval.
+func PullRequestStaleApprovalDetector() (string, PullRequestDetect) {
+	return "PullRequestStaleApprovalDetector", func(c *common, pr *remote.PullRequest) (bool, violation.Violation, error) {
+		if !pr.Merged || pr.HeadRefOid == "" {
+			return false, nil, nil
+		}
+
+		var last *remote.Review
+		for _, a := range approvals(pr) {
+			// Approvals from before the commit was recorded can not be compared.
+			if a.CommitOid == "" || a.CommitOid == pr.HeadRefOid {
+				return false, nil, nil
+			}
+
+			if last == nil || a.SubmittedAt.After(*last.SubmittedAt) {
+				last = a
+			}
+		}
+
+		if last == nil {
+			return false, nil, nil
+		}
+
+		// Pull request must have closed time if merged.
+		if pr.ClosedAt == nil {
+			return false, nil, violation.ErrClosedTimePullRequest
+		}
+
+		return true, violation.NewStaleApprovalViolation(
+			markupPR(c, pr),
+			markup.Commit{
+				Hash: last.CommitOid,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			last.Author.Login,
+			c.IsCurrentPR(pr),
+			*pr.ClosedAt,
+			pr.Author.Login,
+		), nil
+	}
+}
+
+// approvals are the approving reviews from someone other than the author.
+func approvals(pr *remote.PullRequest) []*remote.Review {
+	var reviews []*remote.Review
+	for _, r := range pr.Approvals {
+		if r.SubmittedAt == nil || r.Author == nil {
+			continue
+		}
+
+		if pr.Author != nil && r.Author.Login == pr.Author.Login {
+			continue
+		}
+
+		reviews = append(reviews, r)
+	}
+
+	return reviews
+}
+
+// firstApproval is the earliest approval from someone other than the author, nil when there is none.
+func firstApproval(pr *remote.PullRequest) *remote.Review {
+	var first *remote.Review
+	for _, r := range approvals(pr) {
+		if first == nil || r.SubmittedAt.Before(*first.SubmittedAt) {
+			first = r
+		}
+	}
+
+	return first
+}
+
+func markupPR(c *common, pr *remote.PullRequest) markup.PR {
+	return markup.PR{
+		Number: pr.Number,
+		GitHubLink: markup.GitHubLink{
+			Owner: c.owner,
+			Repo:  c.repo,
+		},
+	}
+}
+
+// PullRequestDescriptionDetector finds pull requests with empty descriptions, or descriptions that leave sections
+// of the pull request template missing or unfilled, or mandatory checklist items unchecked.
+func PullRequestDescriptionDetector() (string, PullRequestDetect) {
+	return "PullRequestDescriptionDetector", func(c *common, pr *remote.PullRequest) (bool, violation.Violation, error) {
+		// Closed pull requests that were not merged were never integrated.
+		if pr.Closed && !pr.Merged {
+			return false, nil, nil
+		}
+
+		prTemplates := c.Templates(pr.Number)
+		templates := make([]prtemplate.Template, len(prTemplates))
+		for i, t := range prTemplates {
+			templates[i] = prtemplate.Parse(t)
+		}
+
+		problems := prtemplate.Check(pr.Body, prtemplate.Best(pr.Body, templates))
+		if len(problems) == 0 {
+			return false, nil, nil
+		}
+
+		if pr.CreatedAt == nil {
+			return false, nil, violation.ErrCreatedTimePullRequest
+		}
+
+		messages := make([]string, len(problems))
+		for i, p := range problems {
+			messages[i] = p.Message
+		}
+
+		return true, violation.NewPullRequestDescriptionViolation(
+			markupPR(c, pr),
+			messages,
+			len(templates) != 0,
+			c.IsCurrentPR(pr),
+			*pr.CreatedAt,
+			pr.Author.Login,
+		), nil
+	}
+}
@@ -1,8 +1,11 @@
 package detector
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
@@ -33,3 +36,228 @@ func TestPullRequestLinkedIssue(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestSizeDetector(t *testing.T) {
+	created := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	params := config.DefaultParameters().PullRequestSizeDetector
+	commits := func(n int) []*remote.PullRequestCommit {
+		return make([]*remote.PullRequestCommit, n)
+	}
+
+	tests := []struct {
+		name string
+		pr   *remote.PullRequest
+		want string
+	}{
+		{
+			"small",
+			&remote.PullRequest{Files: []*remote.PullRequestFile{{Path: "main.go", Additions: 120, Deletions: 30}}},
+			"",
+		},
+		{
+			"generated",
+			&remote.PullRequest{Files: []*remote.PullRequestFile{
+				{Path: "main.go", Additions: 50},
+				{Path: "api/api.pb.go", Additions: 4000},
+				{Path: "vendor/github.com/pkg/errors/errors.go", Additions: 300},
+				{Path: "go.sum", Additions: 200, Deletions: 100},
+			}},
+			"",
+		},
+		{
+			"lines",
+			&remote.PullRequest{Files: []*remote.PullRequestFile{
+				{Path: "server.go", Additions: 350, Deletions: 100},
+				{Path: "web/dist/app.js", Additions: 9000},
+			}},
+			"changes 450 lines in 1 files over 0 commits, more than 400 lines",
+		},
+		{
+			"totals",
+			&remote.PullRequest{Additions: 10, ChangedFiles: 25, Commits: commits(21)},
+			"changes 10 lines in 25 files over 21 commits, more than 20 files and 20 commits",
+		},
+		{
+			"closed",
+			&remote.PullRequest{Closed: true, Additions: 1000},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.pr.CreatedAt = &created
+			tt.pr.Author = &remote.Author{Login: "gopher"}
+			_, detect := PullRequestSizeDetector(params)
+
+			detected, v, err := detect(&common{}, tt.pr)
+			if err != nil {
+				t.Fatalf("detect() error = %v", err)
+			}
+
+			if tt.want == "" {
+				if detected || v != nil {
+					t.Errorf("detect() = %v, %v, want no violation", detected, v)
+				}
+
+				return
+			}
+
+			if !detected || v == nil || !strings.HasSuffix(v.Message(), tt.want) {
+				t.Errorf("detect() = %v, %v, want message ending %s", detected, v, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	patterns := []string{"vendor/", "*.pb.go", "web/dist/*", "/go.sum"}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"vendor/github.com/a/a.go", true},
+		{"tools/vendor/b.go", true},
+		{"vendored.go", false},
+		{"api/v1/api.pb.go", true},
+		{"web/dist/app.js", true},
+		{"dist/app.js", false},
+		{"go.sum", true},
+		{"tools/go.sum", false},
+		{"main.go", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchPath(tt.name, patterns); got != tt.want {
+				t.Errorf("matchPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestReviewDetectors(t *testing.T) {
+	opened := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	merged := opened.Add(24 * time.Hour)
+	head, first, second := local.Hash{3}.HexString(), local.Hash{1}.HexString(), local.Hash{2}.HexString()
+	approval := func(login string, after time.Duration, oid string) *remote.Review {
+		submitted := opened.Add(after)
+
+		return &remote.Review{Author: &remote.Author{Login: login}, SubmittedAt: &submitted, CommitOid: oid}
+	}
+	pr := func(additions int, mergedBy string, approvals ...*remote.Review) *remote.PullRequest {
+		return &remote.PullRequest{
+			Number:     1,
+			CreatedAt:  &opened,
+			ClosedAt:   &merged,
+			Merged:     true,
+			MergedBy:   &remote.Author{Login: mergedBy},
+			Author:     &remote.Author{Login: "author"},
+			HeadRefOid: head,
+			Additions:  additions,
+			Approvals:  approvals,
+		}
+	}
+
+	_, rubberStamp := PullRequestRubberStampDetector(config.DefaultParameters().PullRequestRubberStampDetector, nil)
+	_, selfMerge := PullRequestSelfMergeDetector()
+	_, staleApproval := PullRequestStaleApprovalDetector()
+
+	tests := []struct {
+		name   string
+		detect PullRequestDetect
+		pr     *remote.PullRequest
+		want   string
+	}{
+		{
+			"rubber stamp", rubberStamp,
+			pr(2000, "reviewer", approval("reviewer", 2*time.Minute, head)),
+			"changing 2000 lines was approved by @reviewer 2m0s after it was opened",
+		},
+		{"reviewed", rubberStamp, pr(2000, "reviewer", approval("reviewer", time.Hour, head)), ""},
+		{"small", rubberStamp, pr(50, "reviewer", approval("reviewer", time.Second, head)), ""},
+		{"self approved", rubberStamp, pr(2000, "author", approval("author", time.Second, head)), ""},
+		{
+			"self merge", selfMerge,
+			pr(10, "author", approval("author", time.Hour, head)),
+			"was merged by @author, its author, without approval from another reviewer",
+		},
+		{"approved self merge", selfMerge, pr(10, "author", approval("reviewer", time.Hour, head)), ""},
+		{"merged by reviewer", selfMerge, pr(10, "reviewer"), ""},
+		{
+			"stale approval", staleApproval,
+			pr(10, "author", approval("reviewer", time.Hour, first), approval("other", 2*time.Hour, second)),
+			"was last approved by @other at [0200000](https://github.com///commit/" + second + "), " +
+				"changes pushed after the approval were merged without review",
+		},
+		{
+			"approved head", staleApproval,
+			pr(10, "author", approval("reviewer", time.Hour, first), approval("reviewer", 2*time.Hour, head)),
+			"",
+		},
+		{"unapproved", staleApproval, pr(10, "author"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detected, v, err := tt.detect(&common{}, tt.pr)
+			if err != nil {
+				t.Fatalf("detect() error = %v", err)
+			}
+
+			if tt.want == "" {
+				if detected || v != nil {
+					t.Errorf("detect() = %v, %v, want no violation", detected, v)
+				}
+
+				return
+			}
+
+			if !detected || v == nil || !strings.HasSuffix(v.Message(), tt.want) {
+				t.Errorf("detect() = %v, %v, want message ending %s", detected, v, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestDescriptionDetector(t *testing.T) {
+	created := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	_, detect := PullRequestDescriptionDetector()
+	c := &common{templates: map[int][]string{
+		2: {"## Summary\n<!-- What and why -->\n## Checklist\n- [ ] Tests pass\n"},
+	}}
+
+	tests := []struct {
+		name   string
+		number int
+		body   string
+		want   string
+	}{
+		{"described", 1, "Add pagination to the issues query", ""},
+		{"empty", 1, "", "description is empty"},
+		{"followed", 2, "## Summary\nAdd pagination\n## Checklist\n- [x] Tests pass", ""},
+		{
+			"template", 2, "## Summary\n<!-- What and why -->\n## Checklist\n- [ ] Tests pass\n",
+			"section \"Summary\" was not filled in, checklist item \"Tests pass\" is not checked",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &remote.PullRequest{Number: tt.number, Body: tt.body, CreatedAt: &created, Author: &remote.Author{}}
+
+			detected, v, err := detect(c, pr)
+			if err != nil {
+				t.Fatalf("detect() error = %v", err)
+			}
+
+			if tt.want == "" {
+				if detected || v != nil {
+					t.Errorf("detect() = %v, %v, want no violation", detected, v)
+				}
+
+				return
+			}
+
+			if !detected || v == nil || !strings.HasSuffix(v.Message(), tt.want) {
+				t.Errorf("detect() = %v, %v, want message ending %s", detected, v, tt.want)
+			}
+		})
+	}
+}
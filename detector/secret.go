@@ -0,0 +1,179 @@
+package detector
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/secret"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrSecretModelNil = errors.New("secret model is nil")
+
+// SecretDetector finds secrets and sensitive files added by commits, including those deleted by later commits.
+type SecretDetector struct {
+	name       string
+	violated   int // commits that added secrets
+	found      int // commits without secrets
+	total      int // total commits
+	violations []violation.Violation
+
+	params config.SecretParameters
+}
+
+// NewSecretDetector creates a new secret detector.
+func NewSecretDetector(name string, params config.SecretParameters) *SecretDetector {
+	return &SecretDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+	}
+}
+
+// removal is a commit that deleted a secret or sensitive file.
+type removal struct {
+	hash local.Hash
+	when time.Time
+}
+
+func (sd *SecretDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrSecretModelNil
+	}
+
+	sd.violated = 0
+	sd.found = 0
+	sd.total = 0
+	sd.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	// Oldest first so secrets are reported by the commit that introduced them.
+	commits := make([]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[i] = &em.Commits[i]
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Committer.When.Before(commits[j].Committer.When)
+	})
+
+	deletedSecrets, deletedFiles := sd.removals(commits)
+	reported := make(map[string]bool)
+
+	for _, commit := range commits {
+		sd.total++
+		leaked := false
+
+		for _, diff := range commit.DiffToParents {
+			if secret.IsSensitiveFile(diff.Name, sd.params.Filenames) && diff.Addition != "" && !reported[diff.Name] {
+				reported[diff.Name] = true
+				leaked = true
+				sd.violations = append(sd.violations, violation.NewSensitiveFileViolation(
+					markup.File{Commit: sd.markupCommit(c, commit.Hash), Filepath: diff.Name},
+					sd.deletedIn(c, deletedFiles[diff.Name], commit.Committer.When),
+					commit.Committer.Email,
+					commit.Committer.When,
+					c.IsCurrentCommit(commit.Hash),
+				))
+			}
+
+			for _, line := range diff.AddedLines() {
+				for _, f := range secret.Scan(line.Content, sd.params.EntropyThreshold) {
+					if reported[f.Secret] {
+						continue
+					}
+					reported[f.Secret] = true
+					leaked = true
+
+					sd.violations = append(sd.violations, violation.NewSecretViolation(
+						markup.Line{
+							File:  markup.File{Commit: sd.markupCommit(c, commit.Hash), Filepath: diff.Name},
+							Start: line.Number,
+						},
+						f.Rule,
+						secret.Redact(f.Secret),
+						sd.deletedIn(c, deletedSecrets[f.Secret], commit.Committer.When),
+						commit.Committer.Email,
+						commit.Committer.When,
+						c.IsCurrentCommit(commit.Hash),
+					))
+				}
+			}
+		}
+
+		if leaked {
+			sd.violated++
+		} else {
+			sd.found++
+		}
+	}
+
+	return nil
+}
+
+// removals finds the commits that deleted secrets and sensitive files.
+func (sd *SecretDetector) removals(commits []*local.Commit) (map[string][]removal, map[string][]removal) {
+	secrets := make(map[string][]removal)
+	files := make(map[string][]removal)
+
+	for _, commit := range commits {
+		r := removal{hash: commit.Hash, when: commit.Committer.When}
+		for _, diff := range commit.DiffToParents {
+			if diff.Addition == "" && diff.Deletion != "" && secret.IsSensitiveFile(diff.Name, sd.params.Filenames) {
+				files[diff.Name] = append(files[diff.Name], r)
+			}
+
+			for _, line := range strings.Split(diff.Deletion, "\n") {
+				for _, f := range secret.Scan(line, sd.params.EntropyThreshold) {
+					secrets[f.Secret] = append(secrets[f.Secret], r)
+				}
+			}
+		}
+	}
+
+	return secrets, files
+}
+
+// deletedIn is the first removal after the secret was added, nil when the secret has not been deleted.
+func (sd *SecretDetector) deletedIn(c *common, removals []removal, added time.Time) *markup.Commit {
+	for _, r := range removals {
+		if r.when.After(added) {
+			commit := sd.markupCommit(c, r.hash)
+
+			return &commit
+		}
+	}
+
+	return nil
+}
+
+func (sd *SecretDetector) markupCommit(c *common, hash local.Hash) markup.Commit {
+	return markup.Commit{
+		Hash: hash.HexString(),
+		GitHubLink: markup.GitHubLink{
+			Owner: c.owner,
+			Repo:  c.repo,
+		},
+	}
+}
+
+func (sd *SecretDetector) Result() (int, int, int, []violation.Violation) {
+	return sd.violated, sd.found, sd.total, sd.violations
+}
+
+func (sd *SecretDetector) Name() string {
+	return sd.name
+}
@@ -0,0 +1,286 @@
+package detector
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/violation"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrTestAccompanimentModelNil = errors.New("test accompaniment model is nil")
+
+// TestAccompanimentDetector finds authors and pull requests changing production source files without changing
+// their tests. A test file belongs to a source file when its name without the test pattern is the name of the
+// source file, eg: parser_test.go and parser_internal_test.go belong to parser.go.
+type TestAccompanimentDetector struct {
+	name       string
+	violated   int // commits changing source files without their tests
+	found      int // commits changing source files with their tests
+	total      int // total commits changing source files
+	violations []violation.Violation
+
+	params    config.TestAccompanimentParameters
+	generated []string
+}
+
+// NewTestAccompanimentDetector creates a new test accompaniment detector, generated files are not source files.
+func NewTestAccompanimentDetector(
+	name string,
+	params config.TestAccompanimentParameters,
+	generated []string,
+) *TestAccompanimentDetector {
+	return &TestAccompanimentDetector{
+		name:       name,
+		violated:   0,
+		found:      0,
+		total:      0,
+		violations: make([]violation.Violation, 0),
+		params:     params,
+		generated:  generated,
+	}
+}
+
+// testRatio counts the source changes of an author with test changes.
+type testRatio struct {
+	tested  int
+	total   int
+	last    time.Time
+	current bool
+}
+
+func (ta *TestAccompanimentDetector) Run(em *enriched.EnrichedModel) error {
+	if em == nil {
+		return ErrTestAccompanimentModelNil
+	}
+
+	ta.violated = 0
+	ta.found = 0
+	ta.total = 0
+	ta.violations = make([]violation.Violation, 0)
+
+	c, err := NewCommon(em)
+	if err != nil {
+		log.Printf("could not create common: %v", err)
+	}
+
+	commits := make(map[local.Hash]*local.Commit, len(em.Commits))
+	authors := make(map[string]*testRatio)
+
+	for i := range em.Commits {
+		commit := &em.Commits[i]
+		commits[commit.Hash] = commit
+
+		// Merge commits repeat the changes of the merged branch.
+		if len(commit.ParentHashes) > 1 {
+			continue
+		}
+
+		names := make([]string, len(commit.DiffToParents))
+		for j, diff := range commit.DiffToParents {
+			names[j] = diff.Name
+		}
+
+		tested, total := ta.testedSources(names)
+		if total == 0 {
+			continue
+		}
+
+		ta.total++
+		r, ok := authors[commit.Author.Email]
+		if !ok {
+			r = &testRatio{}
+			authors[commit.Author.Email] = r
+		}
+		r.total++
+		r.current = r.current || c.IsCurrentCommit(commit.Hash)
+		if commit.Author.When.After(r.last) {
+			r.last = commit.Author.When
+		}
+
+		if tested == 0 {
+			ta.violated++
+
+			continue
+		}
+		ta.found++
+		r.tested++
+	}
+
+	emails := make([]string, 0, len(authors))
+	for email := range authors {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	for _, email := range emails {
+		r := authors[email]
+		if float64(r.tested) >= ta.params.MinRatio*float64(r.total) {
+			continue
+		}
+
+		ta.violations = append(ta.violations, violation.NewTestAccompanimentViolation(
+			nil,
+			r.tested,
+			r.total,
+			ta.params.MinRatio,
+			email,
+			r.last,
+			r.current,
+		))
+	}
+
+	for _, pr := range em.PullRequests {
+		// Closed pull requests that were not merged were never integrated.
+		if pr.Closed && !pr.Merged || pr.CreatedAt == nil {
+			continue
+		}
+
+		tested, total := ta.testedSources(pullRequestFiles(pr, em.PullRequestCommits[pr.Number], commits))
+		if total == 0 || float64(tested) >= ta.params.MinRatio*float64(total) {
+			continue
+		}
+
+		login := ""
+		if pr.Author != nil {
+			login = pr.Author.Login
+		}
+
+		ta.violations = append(ta.violations, violation.NewTestAccompanimentViolation(
+			&markup.PR{
+				Number: pr.Number,
+				GitHubLink: markup.GitHubLink{
+					Owner: c.owner,
+					Repo:  c.repo,
+				},
+			},
+			tested,
+			total,
+			ta.params.MinRatio,
+			login,
+			*pr.CreatedAt,
+			c.IsCurrentPR(pr),
+		))
+	}
+
+	return nil
+}
+
+func (ta *TestAccompanimentDetector) Result() (int, int, int, []violation.Violation) {
+	return ta.violated, ta.found, ta.total, ta.violations
+}
+
+func (ta *TestAccompanimentDetector) Name() string {
+	return ta.name
+}
+
+// testedSources counts the changed source files, and the source files with a changed test file.
+func (ta *TestAccompanimentDetector) testedSources(names []string) (int, int) {
+	var sources, tests []string
+	for _, name := range names {
+		switch {
+		case matchPath(name, ta.params.TestPaths):
+			tests = append(tests, testName(name, ta.params.TestPaths))
+		case ta.isSource(name):
+			sources = append(sources, strings.ToLower(strings.TrimSuffix(path.Base(name), path.Ext(name))))
+		}
+	}
+
+	tested := 0
+	for _, source := range sources {
+		for _, test := range tests {
+			if belongsTo(test, source) {
+				tested++
+
+				break
+			}
+		}
+	}
+
+	return tested, len(sources)
+}
+
+// belongsTo checks the test is named after the source, or starts with it followed by a separator,
+// eg: parser_internal belongs to parser.
+func belongsTo(test, source string) bool {
+	if !strings.HasPrefix(test, source) {
+		return false
+	}
+
+	return len(test) == len(source) || strings.ContainsRune("_-.", rune(test[len(source)]))
+}
+
+// isSource checks the file is a production source file that is not generated.
+func (ta *TestAccompanimentDetector) isSource(name string) bool {
+	if matchPath(name, ta.generated) {
+		return false
+	}
+
+	for _, ext := range ta.params.SourceExtensions {
+		if path.Ext(name) == ext {
+			return true
+		}
+	}
+
+	return false
+}
+
+// testName is the name of the source file a test file belongs to: the * of the test pattern matching the file
+// name, or the file name without its extension for tests in test directories.
+func testName(name string, patterns []string) string {
+	base := path.Base(name)
+	for _, pattern := range patterns {
+		if strings.Count(pattern, "*") != 1 || strings.Contains(pattern, "/") {
+			continue
+		}
+
+		if ok, err := path.Match(pattern, base); err != nil || !ok {
+			continue
+		}
+
+		i := strings.Index(pattern, "*")
+		prefix, suffix := pattern[:i], pattern[i+1:]
+
+		return strings.ToLower(base[len(prefix) : len(base)-len(suffix)])
+	}
+
+	return strings.ToLower(strings.TrimSuffix(base, path.Ext(base)))
+}
+
+// pullRequestFiles are the files changed by the pull request. Pull requests without the changed files use the
+// files changed by their commits.
+func pullRequestFiles(pr *remote.PullRequest, hashes []local.Hash, commits map[local.Hash]*local.Commit) []string {
+	var names []string
+	if len(pr.Files) != 0 {
+		for _, f := range pr.Files {
+			names = append(names, f.Path)
+		}
+
+		return names
+	}
+
+	seen := make(map[string]bool)
+	for _, hash := range hashes {
+		commit, ok := commits[hash]
+		if !ok || len(commit.ParentHashes) > 1 {
+			continue
+		}
+
+		for _, diff := range commit.DiffToParents {
+			if !seen[diff.Name] {
+				seen[diff.Name] = true
+				names = append(names, diff.Name)
+			}
+		}
+	}
+
+	return names
+}
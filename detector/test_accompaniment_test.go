@@ -0,0 +1,88 @@
+package detector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestTestAccompanimentDetector(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(hash byte, email string, names ...string) local.Commit {
+		c := local.Commit{
+			Hash:         local.Hash{hash},
+			ParentHashes: []local.Hash{{hash - 1}},
+			Author:       local.Signature{Email: email, When: start.Add(time.Hour * time.Duration(hash))},
+		}
+		for _, name := range names {
+			c.DiffToParents = append(c.DiffToParents, local.Diff{Name: name, Addition: "x\n"})
+		}
+
+		return c
+	}
+
+	em := enriched.NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			commit(1, "gopher@example.com", "parser/parser.go", "parser/parser_internal_test.go"),
+			commit(2, "gopher@example.com", "src/main/java/Lexer.java", "src/test/java/LexerTest.java"),
+			commit(3, "gopher@example.com", "README.md", "parser/parser.pb.go"),
+			commit(4, "student@example.com", "web/app.ts", "web/app.spec.ts"),
+			commit(5, "student@example.com", "web/api.ts", "web/app.spec.ts"),
+			commit(6, "student@example.com", "cli/parse.py", "tests/test_parser.py"),
+		},
+	}, remote.RemoteModel{
+		Owner: "Git-Gopher",
+		Name:  "tests",
+		PullRequests: []*remote.PullRequest{
+			{
+				Number:    1,
+				Merged:    true,
+				CreatedAt: &start,
+				Author:    &remote.Author{Login: "student"},
+				Files: []*remote.PullRequestFile{
+					{Path: "web/app.ts"}, {Path: "web/api.ts"}, {Path: "cli/parse.py"}, {Path: "web/app.spec.ts"},
+				},
+			},
+			{
+				Number:    2,
+				Closed:    true,
+				CreatedAt: &start,
+				Author:    &remote.Author{Login: "student"},
+				Files:     []*remote.PullRequestFile{{Path: "web/api.ts"}},
+			},
+		},
+	})
+
+	p := config.DefaultParameters()
+	d := NewTestAccompanimentDetector("TestAccompanimentDetector", p.TestAccompanimentDetector,
+		p.PullRequestSizeDetector.GeneratedPaths)
+	if err := d.Run(em); err != nil {
+		t.Fatal(err)
+	}
+
+	violated, found, total, violations := d.Result()
+	if violated != 2 || found != 3 || total != 5 {
+		t.Errorf("Result() = %d, %d, %d, want 2, 3, 5", violated, found, total)
+	}
+
+	pr := markup.PR{Number: 1, GitHubLink: markup.GitHubLink{Owner: "Git-Gopher", Repo: "tests"}}
+	want := []string{
+		"Only 1 of 3 commits changing source files also change their tests (33%), less than 50%",
+		"Pull request at " + pr.Markdown() + " changes 3 source files but only changes the tests of 1 (33%), " +
+			"less than 50%",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(violations), len(want))
+	}
+
+	for i, v := range violations {
+		if got := v.Message(); got != want[i] {
+			t.Errorf("Message() = %q, want %q", got, want[i])
+		}
+	}
+}
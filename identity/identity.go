@@ -0,0 +1,383 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var _ Resolver = &resolver{}
+
+var (
+	ErrIdentityNotFound      = errors.New("identity not found")
+	ErrIdentityCannotBeEmpty = errors.New("identity login and email cannot be empty")
+
+	// noreplyRegexp matches GitHub private emails such as 1234+login@users.noreply.github.com.
+	noreplyRegexp = regexp.MustCompile(`^(?:\d+\+)?([^@]+)@users\.noreply\.github\.com$`)
+	// nameRegexp strips everything but letters and digits when comparing names.
+	nameRegexp = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+)
+
+// Source is the origin of a link between an email or name and a person.
+type Source int
+
+const (
+	// Manual mappings provided by the user.
+	Manual Source = iota
+	// GitHubCommitter is a commit author or committer as reported by GitHub.
+	GitHubCommitter
+	// Noreply is a login derived from a users.noreply.github.com address.
+	Noreply
+	// Mailmap is an alias defined in the repository .mailmap.
+	Mailmap
+	// PullRequestAuthor is the author or merger of a pull request.
+	PullRequestAuthor
+	// IssueAuthor is the author of an issue.
+	IssueAuthor
+	// Lookup is a name from a marker lookup file.
+	Lookup
+	// NameMatch is a local signature that only matched a known name.
+	NameMatch
+)
+
+// Source string lookup.
+func (s Source) String() string {
+	return [...]string{
+		"Manual",
+		"GitHubCommitter",
+		"Noreply",
+		"Mailmap",
+		"PullRequestAuthor",
+		"IssueAuthor",
+		"Lookup",
+		"NameMatch",
+	}[s]
+}
+
+// Confidence of a link made by the source, between 0 and 1.
+func (s Source) Confidence() float64 {
+	return [...]float64{
+		1.0,  // Manual
+		0.95, // GitHubCommitter
+		0.9,  // Noreply
+		0.9,  // Mailmap
+		0.8,  // PullRequestAuthor
+		0.8,  // IssueAuthor
+		0.6,  // Lookup
+		0.5,  // NameMatch
+	}[s]
+}
+
+// Person is a canonical identity that owns one GitHub login.
+type Person struct {
+	Login  string
+	Emails []string
+	Names  []string
+	Bot    bool
+}
+
+// Mapping is a manual link between a login and an email and/or name.
+// The source defaults to Manual.
+type Mapping struct {
+	Login  string
+	Email  string
+	Name   string
+	Source Source
+}
+
+// Match is the result of resolving an email or name to a person.
+type Match struct {
+	Person     *Person
+	Source     Source
+	Confidence float64
+}
+
+// Resolver merges signatures from multiple sources into canonical people.
+type Resolver interface {
+	Add(login string, email string, source Source) error
+	AddName(login string, name string, source Source) error
+	AddAlias(alias string, email string)
+	Link(email string, name string) (*Match, error)
+	Resolve(email string, name string) (*Match, error)
+	Check(email string) bool
+	FindUserName(email string) (username *string, err error)
+	Details(username string) ([]string, error)
+	People() []*Person
+}
+
+// link between an email or name and a person.
+type link struct {
+	login  string
+	source Source
+}
+
+type resolver struct {
+	people  map[string]*Person // lowercase login => person
+	emails  map[string]link    // email => person
+	names   map[string]link    // normalised name => person
+	aliases map[string]string  // email => canonical email
+	mutex   sync.RWMutex
+}
+
+func NewResolver() *resolver {
+	return &resolver{
+		people:  make(map[string]*Person),
+		emails:  make(map[string]link),
+		names:   make(map[string]link),
+		aliases: make(map[string]string),
+	}
+}
+
+// Add links an email to a login. A link is only replaced by a source with higher confidence.
+func (r *resolver) Add(login string, email string, source Source) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if login == "" || email == "" {
+		return ErrIdentityCannotBeEmpty
+	}
+
+	key := normaliseEmail(email)
+	person := r.person(login)
+	if existing, ok := r.emails[key]; ok {
+		if existing.source.Confidence() >= source.Confidence() {
+			return nil
+		}
+		r.removeEmail(existing.login, key)
+	}
+
+	r.emails[key] = link{login: login, source: source}
+	person.Emails = append(person.Emails, email)
+	person.Bot = person.Bot || IsBot(email)
+
+	return nil
+}
+
+// AddName links a display name to a login, used as a low confidence fallback.
+func (r *resolver) AddName(login string, name string, source Source) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := normaliseName(name)
+	if login == "" || key == "" {
+		return ErrIdentityCannotBeEmpty
+	}
+
+	person := r.person(login)
+	if existing, ok := r.names[key]; ok && existing.source.Confidence() >= source.Confidence() {
+		return nil
+	}
+
+	r.names[key] = link{login: login, source: source}
+	person.Names = append(person.Names, name)
+	person.Bot = person.Bot || IsBot(name)
+
+	return nil
+}
+
+// AddAlias treats alias as another address of the canonical email, as in a .mailmap.
+func (r *resolver) AddAlias(alias string, email string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	alias, email = normaliseEmail(alias), normaliseEmail(email)
+	if alias == "" || email == "" || alias == email {
+		return
+	}
+
+	r.aliases[alias] = email
+}
+
+// Link resolves a local signature and records the email against the matched person,
+// so later lookups by email alone succeed.
+func (r *resolver) Link(email string, name string) (*Match, error) {
+	match, err := r.Resolve(email, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.RLock()
+	_, known := r.emails[normaliseEmail(email)]
+	r.mutex.RUnlock()
+
+	if email != "" && !known {
+		if err = r.Add(match.Person.Login, email, match.Source); err != nil {
+			return nil, err
+		}
+	}
+
+	return match, nil
+}
+
+// Resolve finds the person for a signature. The email is tried first, then its
+// mailmap alias, then GitHub noreply patterns and lastly the name.
+func (r *resolver) Resolve(email string, name string) (*Match, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	email = normaliseEmail(email)
+
+	if l, ok := r.emails[email]; ok {
+		return r.match(l.login, l.source, 1.0), nil
+	}
+
+	if canonical, ok := r.aliases[email]; ok {
+		if l, ok := r.emails[canonical]; ok {
+			return r.match(l.login, Mailmap, l.source.Confidence()), nil
+		}
+		email = canonical
+	}
+
+	if login := NoreplyLogin(email); login != "" {
+		if _, ok := r.people[loginKey(login)]; !ok {
+			return &Match{
+				Person:     &Person{Login: login, Emails: []string{email}, Bot: IsBot(login)},
+				Source:     Noreply,
+				Confidence: Noreply.Confidence(),
+			}, nil
+		}
+
+		return r.match(login, Noreply, 1.0), nil
+	}
+
+	if l, ok := r.names[normaliseName(name)]; ok && name != "" {
+		return r.match(l.login, NameMatch, l.source.Confidence()), nil
+	}
+
+	return nil, ErrIdentityNotFound
+}
+
+func (r *resolver) Check(email string) bool {
+	_, err := r.Resolve(email, "")
+
+	return err == nil
+}
+
+func (r *resolver) FindUserName(email string) (*string, error) {
+	match, err := r.Resolve(email, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &match.Person.Login, nil
+}
+
+func (r *resolver) Details(username string) ([]string, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	person, ok := r.people[loginKey(username)]
+	if !ok || len(person.Emails) == 0 {
+		return nil, ErrIdentityNotFound
+	}
+
+	emails := make([]string, len(person.Emails))
+	copy(emails, person.Emails)
+
+	return emails, nil
+}
+
+// People returns all canonical people sorted by login.
+func (r *resolver) People() []*Person {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	people := make([]*Person, 0, len(r.people))
+	for _, p := range r.people {
+		people = append(people, p)
+	}
+
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].Login < people[j].Login
+	})
+
+	return people
+}
+
+// person fetches or creates the person for a login. Caller must hold the lock.
+func (r *resolver) person(login string) *Person {
+	p, ok := r.people[loginKey(login)]
+	if !ok {
+		p = &Person{Login: login, Bot: IsBot(login)}
+		r.people[loginKey(login)] = p
+	}
+
+	return p
+}
+
+// removeEmail removes an email from a person. Caller must hold the lock.
+func (r *resolver) removeEmail(login string, email string) {
+	p, ok := r.people[loginKey(login)]
+	if !ok {
+		return
+	}
+
+	for i, e := range p.Emails {
+		if normaliseEmail(e) == email {
+			p.Emails = append(p.Emails[:i], p.Emails[i+1:]...)
+
+			break
+		}
+	}
+}
+
+// match creates a match, scaling the source confidence by factor. Caller must hold the lock.
+func (r *resolver) match(login string, source Source, factor float64) *Match {
+	return &Match{
+		Person:     r.people[loginKey(login)],
+		Source:     source,
+		Confidence: source.Confidence() * factor,
+	}
+}
+
+// AddMappings registers manual mappings with the resolver.
+func AddMappings(r Resolver, mappings []Mapping) error {
+	for _, m := range mappings {
+		if m.Email != "" {
+			if err := r.Add(m.Login, m.Email, m.Source); err != nil {
+				return fmt.Errorf("failed to add mapping for %s: %w", m.Login, err)
+			}
+		}
+
+		if m.Name != "" {
+			if err := r.AddName(m.Login, m.Name, m.Source); err != nil {
+				return fmt.Errorf("failed to add mapping for %s: %w", m.Login, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// NoreplyLogin returns the login of a GitHub noreply email, or empty if it is not one.
+func NoreplyLogin(email string) string {
+	matches := noreplyRegexp.FindStringSubmatch(normaliseEmail(email))
+	if matches == nil {
+		return ""
+	}
+
+	return matches[1]
+}
+
+// IsBot checks if a login, email or name belongs to a bot account such as dependabot[bot].
+func IsBot(s string) bool {
+	s = strings.ToLower(s)
+
+	return strings.Contains(s, "[bot]") || strings.HasSuffix(s, "-bot") || strings.HasPrefix(s, "bot@")
+}
+
+// GitHub logins are case insensitive.
+func loginKey(login string) string {
+	return strings.ToLower(login)
+}
+
+func normaliseEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func normaliseName(name string) string {
+	return nameRegexp.ReplaceAllString(strings.ToLower(name), "")
+}
@@ -0,0 +1,264 @@
+package identity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_resolver_Add(t *testing.T) {
+	type args struct {
+		login string
+		email string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"empty", args{}, true},
+		{"add_no_email", args{"test", ""}, true},
+		{"add_no_login", args{"", "test@test.com"}, true},
+		{"success", args{"test", "test@test.com"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver()
+			if err := r.Add(tt.args.login, tt.args.email, Manual); (err != nil) != tt.wantErr {
+				t.Errorf("resolver.Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_resolver_FindUserName(t *testing.T) {
+	tests := []struct {
+		name      string
+		mappings  []Mapping
+		email     string
+		wantLogin string
+		wantErr   bool
+	}{
+		{"empty", nil, "", "", true},
+		{"not_found", nil, "test@test.com", "", true},
+		{"success", []Mapping{{Login: "test", Email: "test@test.com"}}, "test@test.com", "test", false},
+		{"case_insensitive", []Mapping{{Login: "test", Email: "Test@Test.com"}}, "test@test.com", "test", false},
+		{"noreply", nil, "1234+octocat@users.noreply.github.com", "octocat", false},
+		{"noreply_legacy", nil, "octocat@users.noreply.github.com", "octocat", false},
+		{
+			"noreply_known",
+			[]Mapping{{Login: "OctoCat", Email: "octo@cat.com"}},
+			"1234+octocat@users.noreply.github.com",
+			"OctoCat",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver()
+			if err := AddMappings(r, tt.mappings); err != nil {
+				t.Fatalf("AddMappings() error = %v", err)
+			}
+
+			got, err := r.FindUserName(tt.email)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolver.FindUserName() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != nil && *got != tt.wantLogin {
+				t.Errorf("resolver.FindUserName() = %v, want %v", *got, tt.wantLogin)
+			}
+		})
+	}
+}
+
+func Test_resolver_Details(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappings []Mapping
+		username string
+		want     []string
+		wantErr  bool
+	}{
+		{"empty", nil, "", nil, true},
+		{"data", []Mapping{{Login: "test", Email: "test@email.com"}}, "test", []string{"test@email.com"}, false},
+		{
+			"multiple",
+			[]Mapping{{Login: "test", Email: "test@email.com"}, {Login: "Test", Email: "Test@work.com"}},
+			"test",
+			[]string{"test@email.com", "Test@work.com"},
+			false,
+		},
+		{"name_only", []Mapping{{Login: "test", Name: "Test User"}}, "test", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver()
+			if err := AddMappings(r, tt.mappings); err != nil {
+				t.Fatalf("AddMappings() error = %v", err)
+			}
+
+			got, err := r.Details(tt.username)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolver.Details() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolver.Details() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resolver_Check(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappings []Mapping
+		email    string
+		want     bool
+	}{
+		{"empty", nil, "", false},
+		{"not_found", nil, "test@test.com", false},
+		{"success", []Mapping{{Login: "test", Email: "test@test.com"}}, "test@test.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver()
+			if err := AddMappings(r, tt.mappings); err != nil {
+				t.Fatalf("AddMappings() error = %v", err)
+			}
+
+			if got := r.Check(tt.email); got != tt.want {
+				t.Errorf("resolver.Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resolver_Precedence(t *testing.T) {
+	r := NewResolver()
+	_ = r.Add("guess", "test@test.com", IssueAuthor)
+	_ = r.Add("actual", "test@test.com", GitHubCommitter)
+	_ = r.Add("ignored", "test@test.com", NameMatch)
+
+	got, err := r.FindUserName("test@test.com")
+	if err != nil || *got != "actual" {
+		t.Fatalf("resolver.FindUserName() = %v, %v, want actual", got, err)
+	}
+
+	if emails, err := r.Details("guess"); err == nil {
+		t.Errorf("resolver.Details() = %v, want replaced link removed", emails)
+	}
+}
+
+func Test_resolver_Link(t *testing.T) {
+	r := NewResolver()
+	_ = r.Add("test", "test@test.com", GitHubCommitter)
+	_ = r.AddName("test", "Test User", GitHubCommitter)
+
+	match, err := r.Link("laptop@home.local", "test-user")
+	if err != nil {
+		t.Fatalf("resolver.Link() error = %v", err)
+	}
+	if match.Person.Login != "test" || match.Source != NameMatch {
+		t.Errorf("resolver.Link() = %+v, want name match for test", match)
+	}
+	if match.Confidence >= GitHubCommitter.Confidence() {
+		t.Errorf("resolver.Link() confidence = %v, want lower than direct link", match.Confidence)
+	}
+
+	// The linked email now resolves without the name.
+	if got, err := r.FindUserName("laptop@home.local"); err != nil || *got != "test" {
+		t.Errorf("resolver.FindUserName() = %v, %v, want test", got, err)
+	}
+
+	if _, err := r.Link("stranger@home.local", "Stranger"); err == nil {
+		t.Errorf("resolver.Link() error = nil, want %v", ErrIdentityNotFound)
+	}
+}
+
+func Test_resolver_People(t *testing.T) {
+	r := NewResolver()
+	_ = r.Add("zed", "zed@test.com", Manual)
+	_ = r.Add("dependabot[bot]", "49699333+dependabot[bot]@users.noreply.github.com", Noreply)
+	_ = r.Add("Amy", "amy@test.com", Manual)
+	_ = r.Add("amy", "amy@work.com", Manual)
+
+	people := r.People()
+	logins := make([]string, 0, len(people))
+	for _, p := range people {
+		logins = append(logins, p.Login)
+	}
+
+	if want := []string{"Amy", "dependabot[bot]", "zed"}; !reflect.DeepEqual(logins, want) {
+		t.Errorf("resolver.People() = %v, want %v", logins, want)
+	}
+	if !people[1].Bot || people[0].Bot {
+		t.Errorf("resolver.People() bots = %v, %v, want only dependabot", people[0].Bot, people[1].Bot)
+	}
+}
+
+func TestIsBot(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"dependabot[bot]", true},
+		{"renovate-bot", true},
+		{"bot@example.com", true},
+		{"robot", false},
+		{"abbott@example.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := IsBot(tt.s); got != tt.want {
+				t.Errorf("IsBot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMailmap(t *testing.T) {
+	mailmap := `# comment
+Proper Name <commit@email.xx>
+<proper@email.xx> <commit2@email.xx>
+Proper Name <proper@email.xx> Commit Name <commit3@email.xx> # trailing comment
+invalid line
+`
+	want := []MailmapEntry{
+		{ProperName: "Proper Name", CommitEmail: "commit@email.xx"},
+		{ProperEmail: "proper@email.xx", CommitEmail: "commit2@email.xx"},
+		{ProperName: "Proper Name", ProperEmail: "proper@email.xx", CommitName: "Commit Name", CommitEmail: "commit3@email.xx"},
+	}
+
+	got, err := ParseMailmap(strings.NewReader(mailmap))
+	if err != nil {
+		t.Fatalf("ParseMailmap() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMailmap() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAddMailmap(t *testing.T) {
+	r := NewResolver()
+	_ = r.Add("test", "proper@email.xx", GitHubCommitter)
+
+	entries, err := ParseMailmap(strings.NewReader("Proper Name <proper@email.xx> <old@email.xx>\n"))
+	if err != nil {
+		t.Fatalf("ParseMailmap() error = %v", err)
+	}
+	AddMailmap(r, entries)
+
+	match, err := r.Resolve("old@email.xx", "")
+	if err != nil || match.Person.Login != "test" || match.Source != Mailmap {
+		t.Errorf("resolver.Resolve() = %+v, %v, want mailmap match for test", match, err)
+	}
+
+	match, err = r.Resolve("", "proper name")
+	if err != nil || match.Person.Login != "test" {
+		t.Errorf("resolver.Resolve() = %+v, %v, want name match for test", match, err)
+	}
+}
@@ -0,0 +1,96 @@
+package identity
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MailmapEntry is a single line of a .mailmap file.
+// See https://git-scm.com/docs/gitmailmap for the supported forms.
+type MailmapEntry struct {
+	ProperName  string
+	ProperEmail string
+	CommitName  string
+	CommitEmail string
+}
+
+// ParseMailmap parses the contents of a .mailmap file. Comments and malformed lines are skipped.
+func ParseMailmap(r io.Reader) ([]MailmapEntry, error) {
+	var entries []MailmapEntry
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		if entry, ok := parseMailmapLine(line); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read mailmap: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseMailmapLine parses "Name <email>" pairs, the first pair being the proper identity.
+func parseMailmapLine(line string) (MailmapEntry, bool) {
+	var names, emails []string
+
+	for {
+		open := strings.Index(line, "<")
+		closing := strings.Index(line, ">")
+		if open < 0 || closing < open {
+			break
+		}
+
+		names = append(names, strings.TrimSpace(line[:open]))
+		emails = append(emails, strings.TrimSpace(line[open+1:closing]))
+		line = line[closing+1:]
+	}
+
+	switch len(emails) {
+	case 1:
+		// Proper Name <commit@email.xx>
+		return MailmapEntry{ProperName: names[0], CommitEmail: emails[0]}, names[0] != ""
+	case 2: // nolint: gomnd
+		// [Proper Name] <proper@email.xx> [Commit Name] <commit@email.xx>
+		return MailmapEntry{
+			ProperName:  names[0],
+			ProperEmail: emails[0],
+			CommitName:  names[1],
+			CommitEmail: emails[1],
+		}, true
+	default:
+		return MailmapEntry{}, false
+	}
+}
+
+// AddMailmap registers the aliases and names of mailmap entries with the resolver.
+func AddMailmap(r Resolver, entries []MailmapEntry) {
+	for _, e := range entries {
+		if e.ProperEmail != "" {
+			r.AddAlias(e.CommitEmail, e.ProperEmail)
+		}
+
+		if e.ProperName == "" {
+			continue
+		}
+
+		// Names can only be linked when the proper identity resolves to a person.
+		email := e.ProperEmail
+		if email == "" {
+			email = e.CommitEmail
+		}
+
+		if m, err := r.Resolve(email, ""); err == nil {
+			_ = r.AddName(m.Person.Login, e.ProperName, Mailmap)
+		}
+	}
+}
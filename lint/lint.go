@@ -0,0 +1,241 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Git-Gopher/go-gopher/conventional"
+)
+
+// Rule is a commit message style rule that can be toggled in the config.
+type Rule string
+
+const (
+	SubjectLength  Rule = "subject-length"  // Subject is at most the maximum length.
+	BlankLine      Rule = "blank-line"      // Subject is followed by a blank line.
+	BodyWrap       Rule = "body-wrap"       // Body lines are wrapped at the wrap width.
+	ImperativeMood Rule = "imperative-mood" // Subject starts with a verb in the imperative mood.
+	TrailingPeriod Rule = "trailing-period" // Subject does not end with a period.
+	GenericSubject Rule = "generic-subject" // Subject is not a generic subject, eg: "fix".
+)
+
+// Rules are all the rules, in the order they are checked.
+var Rules = []Rule{SubjectLength, BlankLine, BodyWrap, ImperativeMood, TrailingPeriod, GenericSubject}
+
+// IsRule checks if the name is a known rule.
+func IsRule(name string) bool {
+	for _, r := range Rules {
+		if string(r) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Options of the linter.
+type Options struct {
+	Rules            []Rule   // Enabled rules.
+	MaxSubjectLength int      // Maximum number of characters in the subject.
+	BodyWrapWidth    int      // Maximum number of characters in a body line.
+	GenericSubjects  []string // Subjects that do not describe a change, compared case insensitively.
+}
+
+// Problem is a broken rule within a commit message.
+type Problem struct {
+	Rule       Rule
+	Line       int // Line of the message the problem is on, starting from 1.
+	Message    string
+	Suggestion string
+}
+
+// Lint checks a commit message against the enabled rules.
+func Lint(message string, opts Options) []Problem {
+	message = strings.TrimRight(strings.ReplaceAll(message, "\r\n", "\n"), "\n\t ")
+	lines := strings.Split(message, "\n")
+	subject := lines[0]
+
+	var problems []Problem
+
+	for _, rule := range opts.Rules {
+		switch rule {
+		case SubjectLength:
+			if n := utf8.RuneCountInString(subject); n > opts.MaxSubjectLength {
+				problems = append(problems, Problem{
+					Rule:    rule,
+					Line:    1,
+					Message: fmt.Sprintf("subject is %d characters long, the limit is %d", n, opts.MaxSubjectLength),
+					Suggestion: fmt.Sprintf("Shorten the subject to at most %d characters "+
+						"and move the details into the body", opts.MaxSubjectLength),
+				})
+			}
+		case BlankLine:
+			if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+				problems = append(problems, Problem{
+					Rule:       rule,
+					Line:       2,
+					Message:    "subject is not followed by a blank line",
+					Suggestion: "Leave the second line empty so that git and other tools can separate the subject from the body",
+				})
+			}
+		case BodyWrap:
+			if long := longLines(lines, opts.BodyWrapWidth); len(long) != 0 {
+				problems = append(problems, Problem{
+					Rule: rule,
+					Line: long[0],
+					Message: fmt.Sprintf("body lines %s are longer than %d characters",
+						joinInts(long), opts.BodyWrapWidth),
+					Suggestion: fmt.Sprintf("Wrap the body at %d characters so it can be read in a terminal",
+						opts.BodyWrapWidth),
+				})
+			}
+		case ImperativeMood:
+			word := firstWord(subject)
+			if base, ok := imperative(word); ok {
+				problems = append(problems, Problem{
+					Rule:    rule,
+					Line:    1,
+					Message: fmt.Sprintf("subject starts with \"%s\" which is not in the imperative mood", word),
+					Suggestion: fmt.Sprintf("Start the subject with \"%s\", the subject should complete the sentence "+
+						"\"If applied, this commit will ...\"", matchCase(base, word)),
+				})
+			}
+		case TrailingPeriod:
+			if strings.HasSuffix(subject, ".") && !strings.HasSuffix(subject, "...") {
+				problems = append(problems, Problem{
+					Rule:       rule,
+					Line:       1,
+					Message:    "subject ends with a period",
+					Suggestion: fmt.Sprintf("Remove the trailing period: \"%s\"", strings.TrimRight(subject, ".")),
+				})
+			}
+		case GenericSubject:
+			text := strings.TrimRight(strings.TrimSpace(description(subject)), ".!")
+			for _, generic := range opts.GenericSubjects {
+				if strings.EqualFold(text, generic) {
+					problems = append(problems, Problem{
+						Rule:    rule,
+						Line:    1,
+						Message: fmt.Sprintf("subject \"%s\" does not describe the change", text),
+						Suggestion: "Describe what the commit changes and where, " +
+							"eg: \"Fix crash when the config file is missing\"",
+					})
+
+					break
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// description is the subject without a conventional commit type and scope.
+func description(subject string) string {
+	if m, err := conventional.Parse(subject); err == nil {
+		return m.Description
+	}
+
+	return subject
+}
+
+// firstWord of the subject description.
+func firstWord(subject string) string {
+	fields := strings.Fields(description(subject))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.Trim(fields[0], ",:;.!")
+}
+
+// longLines are the line numbers of body lines longer than the width. Lines without spaces such as links
+// and indented lines such as code can not be wrapped and are allowed.
+func longLines(lines []string, width int) []int {
+	var long []int
+
+	for i := 2; i < len(lines); i++ {
+		line := lines[i]
+		if utf8.RuneCountInString(line) <= width ||
+			!strings.Contains(strings.TrimSpace(line), " ") ||
+			strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		long = append(long, i+1)
+	}
+
+	return long
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprint(v)
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// verbs are common verbs at the start of commit subjects, in the imperative mood.
+var verbs = map[string]struct{}{
+	"add": {}, "allow": {}, "bump": {}, "change": {}, "clean": {}, "create": {}, "delete": {}, "document": {},
+	"drop": {}, "enable": {}, "disable": {}, "extract": {}, "fix": {}, "handle": {}, "implement": {},
+	"improve": {}, "include": {}, "introduce": {}, "make": {}, "merge": {}, "migrate": {}, "move": {},
+	"optimise": {}, "optimize": {}, "prevent": {}, "refactor": {}, "remove": {}, "rename": {}, "replace": {},
+	"reformat": {}, "release": {}, "revert": {}, "rewrite": {}, "set": {}, "simplify": {}, "support": {},
+	"switch": {}, "tidy": {}, "update": {}, "upgrade": {}, "use": {},
+}
+
+// imperative finds the imperative form of a known verb that is in the past tense, present participle
+// or third person, eg: "added", "adding" and "adds" are "add".
+func imperative(word string) (string, bool) {
+	word = strings.ToLower(word)
+	if _, ok := verbs[word]; ok {
+		return "", false
+	}
+
+	var candidates []string
+
+	switch {
+	case strings.HasSuffix(word, "ied"):
+		candidates = append(candidates, strings.TrimSuffix(word, "ied")+"y")
+	case strings.HasSuffix(word, "ies"):
+		candidates = append(candidates, strings.TrimSuffix(word, "ies")+"y")
+	case strings.HasSuffix(word, "ed"):
+		stem := strings.TrimSuffix(word, "ed")
+		candidates = append(candidates, stem, stem+"e")
+		// Doubled final consonant, eg: "dropped".
+		if n := len(stem); n > 1 && stem[n-1] == stem[n-2] {
+			candidates = append(candidates, stem[:n-1])
+		}
+	case strings.HasSuffix(word, "ing"):
+		stem := strings.TrimSuffix(word, "ing")
+		candidates = append(candidates, stem, stem+"e")
+		if n := len(stem); n > 1 && stem[n-1] == stem[n-2] {
+			candidates = append(candidates, stem[:n-1])
+		}
+	case strings.HasSuffix(word, "es"):
+		candidates = append(candidates, strings.TrimSuffix(word, "es"), strings.TrimSuffix(word, "s"))
+	case strings.HasSuffix(word, "s"):
+		candidates = append(candidates, strings.TrimSuffix(word, "s"))
+	}
+
+	for _, c := range candidates {
+		if _, ok := verbs[c]; ok {
+			return c, true
+		}
+	}
+
+	return "", false
+}
+
+// matchCase capitalises the replacement when the original word is capitalised.
+func matchCase(replacement, original string) string {
+	if original == "" || replacement == "" || strings.ToLower(original[:1]) == original[:1] {
+		return replacement
+	}
+
+	return strings.ToUpper(replacement[:1]) + replacement[1:]
+}
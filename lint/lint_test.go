@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	opts := Options{
+		Rules:            Rules,
+		MaxSubjectLength: 50,
+		BodyWrapWidth:    72,
+		GenericSubjects:  []string{"fix", "update", "wip"},
+	}
+
+	tests := []struct {
+		name    string
+		message string
+		want    []Rule
+		lines   []int
+	}{
+		{"conforming", "Add pagination to the issues query\n\nLong body text that wraps at the width.\n", nil, nil},
+		{"subject_length", strings.Repeat("Add ", 13), []Rule{SubjectLength}, []int{1}},
+		{"blank_line", "Add pagination\nto the issues query", []Rule{BlankLine}, []int{2}},
+		{
+			"body_wrap",
+			"Add pagination\n\n" + strings.Repeat("word ", 20) + "\nhttps://example.com/" + strings.Repeat("a", 80) +
+				"\n    " + strings.Repeat("code ", 20) + "\n" + strings.Repeat("word ", 20),
+			[]Rule{BodyWrap},
+			[]int{3},
+		},
+		{"past_tense", "Added pagination", []Rule{ImperativeMood}, []int{1}},
+		{"participle", "fixing the tests", []Rule{ImperativeMood}, []int{1}},
+		{"third_person", "feat(api): adds pagination", []Rule{ImperativeMood}, []int{1}},
+		{"noun", "Readme for the config", nil, nil},
+		{"trailing_period", "Add pagination.", []Rule{TrailingPeriod}, []int{1}},
+		{"ellipsis", "Add pagination...", nil, nil},
+		{"generic", "WIP", []Rule{GenericSubject}, []int{1}},
+		{"generic_conventional", "fix: fix.", []Rule{TrailingPeriod, GenericSubject}, []int{1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules []Rule
+			var lines []int
+			for _, p := range Lint(tt.message, opts) {
+				rules = append(rules, p.Rule)
+				lines = append(lines, p.Line)
+			}
+
+			if !reflect.DeepEqual(rules, tt.want) || !reflect.DeepEqual(lines, tt.lines) {
+				t.Errorf("Lint() rules = %v lines = %v, want %v lines %v", rules, lines, tt.want, tt.lines)
+			}
+		})
+	}
+}
+
+func TestLintDisabledRules(t *testing.T) {
+	problems := Lint("Added pagination.", Options{Rules: []Rule{TrailingPeriod}})
+	if len(problems) != 1 || problems[0].Rule != TrailingPeriod {
+		t.Fatalf("Lint() = %+v, want only %s", problems, TrailingPeriod)
+	}
+
+	if want := `Remove the trailing period: "Added pagination"`; problems[0].Suggestion != want {
+		t.Errorf("Suggestion = %s, want %s", problems[0].Suggestion, want)
+	}
+}
+
+func TestImperative(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+		ok   bool
+	}{
+		{"Add", "", false},
+		{"added", "add", true},
+		{"Updated", "update", true},
+		{"dropped", "drop", true},
+		{"tidied", "tidy", true},
+		{"removing", "remove", true},
+		{"fixes", "fix", true},
+		{"uses", "use", true},
+		{"Tests", "", false},
+		{"documentation", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got, ok := imperative(tt.word)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("imperative() = %s, %v, want %s, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
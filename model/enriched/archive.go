@@ -0,0 +1,286 @@
+package enriched
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/identity"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/Git-Gopher/go-gopher/version"
+)
+
+const (
+	// ArchiveVersion is bumped whenever the archive layout changes. Version 2 added the LFS, ignore and template
+	// patterns, the blob sizes and mode changes of diffs, and the changes of merge commits.
+	ArchiveVersion = 2
+	// ArchiveExtension is the file extension of exported enriched models.
+	ArchiveExtension = ".gopher.gz"
+)
+
+var ErrArchiveVersion = errors.New("unsupported enriched model archive version")
+
+// ArchiveHeader describes an exported enriched model.
+type ArchiveHeader struct {
+	Version       int
+	Created       time.Time
+	GopherVersion string // Build version of the tool that exported the model.
+	Owner         string
+	Name          string
+}
+
+// archive is the serialized form of an enriched model. The go-git repository is not exported,
+// graphs are flattened so shared ancestors are only stored once.
+type archive struct {
+	Owner            string
+	Name             string
+	URL              string
+	DefaultBranch    string
+	Commits          []local.Commit
+	Branches         []local.Branch
+	MainGraph        *archiveGraph
+	ReleaseGraph     *archiveGraph
+	BranchMatrix     []*local.BranchMatrix
+	LocalCommitters  []local.Committer
+	Tags             []*local.Tag
+	Roles            *BranchRoles
+	Mailmap          []identity.MailmapEntry
+	LFSPatterns      []string
+	IgnorePatterns   []local.IgnorePattern
+	Templates        map[string][]string
+	PullRequests     []*remote.PullRequest
+	Issues           []*remote.Issue
+	GithubCommitters []remote.Committer
+}
+
+// archiveGraph is a branch graph as a list of nodes, the first node is the head.
+type archiveGraph struct {
+	BranchName string
+	Nodes      []archiveNode
+}
+
+type archiveNode struct {
+	Hash      string
+	Author    local.Signature
+	Committer local.Signature
+	Parents   []int // Indexes of the parent nodes.
+}
+
+// Export writes the enriched model to a gzip compressed archive.
+func (em *EnrichedModel) Export(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	enc := gob.NewEncoder(zw)
+
+	header := ArchiveHeader{
+		Version:       ArchiveVersion,
+		Created:       time.Now(),
+		GopherVersion: version.BuildVersion(),
+		Owner:         em.Owner,
+		Name:          em.Name,
+	}
+	if err := enc.Encode(header); err != nil {
+		return fmt.Errorf("failed to encode archive header: %w", err)
+	}
+
+	// Merge changes are fetched on demand, the archive has no repository to fetch them from.
+	commits := make([]local.Commit, len(em.Commits))
+	copy(commits, em.Commits)
+	for i := range commits {
+		changes, err := em.MergeChanges(&commits[i])
+		if err != nil {
+			return err
+		}
+		commits[i].MergeChanges = changes
+	}
+
+	a := archive{
+		Owner:            em.Owner,
+		Name:             em.Name,
+		URL:              em.URL,
+		DefaultBranch:    em.DefaultBranch,
+		Commits:          commits,
+		Branches:         em.Branches,
+		MainGraph:        flattenGraph(em.MainGraph),
+		ReleaseGraph:     flattenGraph(em.ReleaseGraph),
+		BranchMatrix:     em.BranchMatrix,
+		LocalCommitters:  em.LocalCommitters,
+		Tags:             em.Tags,
+		Roles:            em.Roles,
+		Mailmap:          em.Mailmap,
+		LFSPatterns:      em.LFSPatterns,
+		IgnorePatterns:   em.IgnorePatterns,
+		Templates:        em.Templates,
+		PullRequests:     em.PullRequests,
+		Issues:           em.Issues,
+		GithubCommitters: em.GithubCommitters,
+	}
+	if err := enc.Encode(a); err != nil {
+		return fmt.Errorf("failed to encode enriched model: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress archive: %w", err)
+	}
+
+	return nil
+}
+
+// Import reads an enriched model from an archive written by Export.
+// Pull request links and merge strategies are recomputed, the repository is not available.
+func Import(r io.Reader) (*EnrichedModel, *ArchiveHeader, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decompress archive: %w", err)
+	}
+	defer zr.Close()
+
+	dec := gob.NewDecoder(zr)
+
+	var header ArchiveHeader
+	if err = dec.Decode(&header); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode archive header: %w", err)
+	}
+
+	if header.Version != ArchiveVersion {
+		return nil, nil, fmt.Errorf("%w: %d, expected %d", ErrArchiveVersion, header.Version, ArchiveVersion)
+	}
+
+	var a archive
+	if err = dec.Decode(&a); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode enriched model: %w", err)
+	}
+
+	em := &EnrichedModel{
+		Owner:            a.Owner,
+		Name:             a.Name,
+		URL:              a.URL,
+		DefaultBranch:    a.DefaultBranch,
+		Commits:          a.Commits,
+		Branches:         a.Branches,
+		MainGraph:        a.MainGraph.graph(),
+		ReleaseGraph:     a.ReleaseGraph.graph(),
+		BranchMatrix:     a.BranchMatrix,
+		LocalCommitters:  a.LocalCommitters,
+		Tags:             a.Tags,
+		Roles:            a.Roles,
+		Mailmap:          a.Mailmap,
+		LFSPatterns:      a.LFSPatterns,
+		IgnorePatterns:   a.IgnorePatterns,
+		Templates:        a.Templates,
+		PullRequests:     a.PullRequests,
+		Issues:           a.Issues,
+		GithubCommitters: a.GithubCommitters,
+	}
+
+	em.LinkPullRequests()
+
+	return em, &header, nil
+}
+
+// ExportFile writes the enriched model archive into the directory, named after the repository.
+func (em *EnrichedModel) ExportFile(dir string) (path string, err error) {
+	if err = os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	path = filepath.Join(dir, fmt.Sprintf("%s-%s%s", em.Owner, em.Name, ArchiveExtension))
+
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			path, err = "", fmt.Errorf("failed to close archive: %w", cerr)
+		}
+	}()
+
+	if err = em.Export(f); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ImportFile reads an enriched model archive from disk.
+func ImportFile(path string) (*EnrichedModel, *ArchiveHeader, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+
+	return Import(f)
+}
+
+// flattenGraph lists the nodes of a graph, each node is stored once.
+func flattenGraph(bg *local.BranchGraph) *archiveGraph {
+	if bg == nil {
+		return nil
+	}
+
+	ag := &archiveGraph{BranchName: bg.BranchName}
+	if bg.Head == nil {
+		return ag
+	}
+
+	node := func(cg *local.CommitGraph) archiveNode {
+		return archiveNode{Hash: cg.Hash, Author: cg.Author, Committer: cg.Committer}
+	}
+
+	// Walk with a stack rather than recursion as histories can be very deep.
+	index := map[*local.CommitGraph]int{bg.Head: 0}
+	ag.Nodes = append(ag.Nodes, node(bg.Head))
+	stack := []*local.CommitGraph{bg.Head}
+	for len(stack) != 0 {
+		n := len(stack) - 1
+		cg := stack[n]
+		stack = stack[:n]
+
+		i := index[cg]
+		for _, p := range cg.ParentCommits {
+			j, ok := index[p]
+			if !ok {
+				j = len(ag.Nodes)
+				index[p] = j
+				ag.Nodes = append(ag.Nodes, node(p))
+				stack = append(stack, p)
+			}
+			ag.Nodes[i].Parents = append(ag.Nodes[i].Parents, j)
+		}
+	}
+
+	return ag
+}
+
+// graph rebuilds the branch graph from the flattened nodes.
+func (ag *archiveGraph) graph() *local.BranchGraph {
+	if ag == nil {
+		return nil
+	}
+
+	bg := &local.BranchGraph{BranchName: ag.BranchName}
+	if len(ag.Nodes) == 0 {
+		return bg
+	}
+
+	nodes := make([]*local.CommitGraph, len(ag.Nodes))
+	for i, n := range ag.Nodes {
+		nodes[i] = &local.CommitGraph{Hash: n.Hash, Author: n.Author, Committer: n.Committer}
+	}
+
+	for i, n := range ag.Nodes {
+		for _, p := range n.Parents {
+			nodes[i].ParentCommits = append(nodes[i].ParentCommits, nodes[p])
+		}
+	}
+	bg.Head = nodes[0]
+
+	return bg
+}
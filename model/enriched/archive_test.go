@@ -0,0 +1,175 @@
+package enriched
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/identity"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	when := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	commits := []local.Commit{
+		commit(1, "initial"),
+		commit(2, "main work", 1),
+		commit(3, "feature", 1),
+		commit(4, "Merge pull request #1 from feature", 2, 3),
+	}
+	for i := range commits {
+		commits[i].Author = local.Signature{Name: "Gopher", Email: "gopher@example.com", When: when}
+		commits[i].DiffToParents = []local.Diff{{Name: "main.go", Addition: "package main\n", Size: 13}}
+	}
+
+	// Diamond shaped graph, the initial commit is shared by both parents of the merge.
+	initial := &local.CommitGraph{Hash: hash(1).HexString()}
+	head := &local.CommitGraph{Hash: hash(4).HexString(), ParentCommits: []*local.CommitGraph{
+		{Hash: hash(2).HexString(), ParentCommits: []*local.CommitGraph{initial}},
+		{Hash: hash(3).HexString(), ParentCommits: []*local.CommitGraph{initial}},
+	}}
+
+	pr := &remote.PullRequest{
+		Number:      1,
+		Merged:      true,
+		BaseRefName: "main",
+		HeadRefName: "feature",
+		MergeCommit: hash(4).HexString(),
+		CreatedAt:   &when,
+		Author:      &remote.Author{Login: "gopher", Email: "gopher@example.com"},
+	}
+
+	em := NewEnrichedModel(
+		local.GitModel{
+			Commits:   commits,
+			Branches:  []local.Branch{{Name: "main", Head: commits[3]}, {Name: "feature", Head: commits[2]}},
+			MainGraph: &local.BranchGraph{BranchName: "main", Head: head},
+			Tags:      []*local.Tag{{Name: "v1.0.0", Head: commits[3]}},
+		},
+		remote.RemoteModel{
+			Owner:        "Git-Gopher",
+			Name:         "go-gopher",
+			URL:          "https://github.com/Git-Gopher/go-gopher",
+			PullRequests: []*remote.PullRequest{pr},
+			Issues:       []*remote.Issue{{Number: 2, Title: "bug", Author: pr.Author}},
+		},
+	)
+	em.LFSPatterns = []string{"*.psd"}
+	em.IgnorePatterns = []local.IgnorePattern{{Dir: "web", Pattern: "dist/"}}
+	em.Templates = map[string][]string{"": {"## Summary\n"}}
+	em.Mailmap = []identity.MailmapEntry{{ProperEmail: "gopher@example.com", CommitEmail: "old@example.com"}}
+	if err := em.AssignBranchRoles(config.BranchRoles{}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := em.Export(&buf); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	got, header, err := Import(&buf)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if header.Version != ArchiveVersion || header.Owner != em.Owner || header.Name != em.Name {
+		t.Errorf("Import() header = %+v", header)
+	}
+
+	if !reflect.DeepEqual(got.Commits, em.Commits) {
+		t.Errorf("Commits = %+v, want %+v", got.Commits, em.Commits)
+	}
+
+	// Empty role lists are decoded as nil.
+	if fmt.Sprint(got.Roles) != fmt.Sprint(em.Roles) {
+		t.Errorf("Roles = %+v, want %+v", got.Roles, em.Roles)
+	}
+
+	if !reflect.DeepEqual(flattenGraph(got.MainGraph), flattenGraph(em.MainGraph)) {
+		t.Errorf("MainGraph = %+v, want %+v", flattenGraph(got.MainGraph), flattenGraph(em.MainGraph))
+	}
+
+	if shared := got.MainGraph.Head.ParentCommits; shared[0].ParentCommits[0] != shared[1].ParentCommits[0] {
+		t.Errorf("shared ancestors should be a single node after import")
+	}
+
+	if !reflect.DeepEqual(got.PullRequestCommits, em.PullRequestCommits) {
+		t.Errorf("PullRequestCommits = %v, want %v", got.PullRequestCommits, em.PullRequestCommits)
+	}
+
+	if got.MergeStrategy(got.PullRequests[0]) != MergeCommitStrategy {
+		t.Errorf("MergeStrategy() = %v, want %v", got.MergeStrategy(got.PullRequests[0]), MergeCommitStrategy)
+	}
+
+	for _, field := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"Branches", got.Branches, em.Branches},
+		{"Tags", got.Tags, em.Tags},
+		{"PullRequests", got.PullRequests, em.PullRequests},
+		{"Issues", got.Issues, em.Issues},
+		{"Mailmap", got.Mailmap, em.Mailmap},
+		{"DefaultBranch", got.DefaultBranch, em.DefaultBranch},
+		{"Templates", got.Templates, em.Templates},
+	} {
+		if !reflect.DeepEqual(field.got, field.want) {
+			t.Errorf("%s = %+v, want %+v", field.name, field.got, field.want)
+		}
+	}
+}
+
+func TestImportVersion(t *testing.T) {
+	// Archives of older versions have a different layout, newer versions are unknown.
+	for _, version := range []int{ArchiveVersion - 1, ArchiveVersion + 1} {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if err := gob.NewEncoder(zw).Encode(ArchiveHeader{Version: version}); err != nil {
+			t.Fatal(err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := Import(&buf); !errors.Is(err, ErrArchiveVersion) {
+			t.Errorf("Import() version %d error = %v, want %v", version, err, ErrArchiveVersion)
+		}
+	}
+}
+
+func TestAssignBranchRolesImported(t *testing.T) {
+	em := NewEnrichedModel(
+		local.GitModel{
+			Commits: []local.Commit{
+				commit(1, "initial"),
+				commit(2, "develop work", 1),
+			},
+			Branches: []local.Branch{
+				{Name: "main", Head: commit(1, "initial")},
+				{Name: "develop", Head: commit(2, "develop work", 1)},
+			},
+			MainGraph: &local.BranchGraph{BranchName: "main"},
+		},
+		remote.RemoteModel{},
+	)
+
+	if err := em.AssignBranchRoles(config.BranchRoles{}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	// Without a repository the graphs are built from the commits of the model.
+	if em.MainGraph.BranchName != "develop" || len(em.MainGraph.Head.ParentCommits) != 1 {
+		t.Errorf("MainGraph = %+v, want develop graph", em.MainGraph)
+	}
+
+	if em.ReleaseGraph == nil || em.ReleaseGraph.BranchName != "main" || em.Roles.Primary != "main" {
+		t.Errorf("ReleaseGraph = %+v, want main graph", em.ReleaseGraph)
+	}
+}
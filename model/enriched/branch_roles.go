@@ -0,0 +1,458 @@
+package enriched
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/go-git/go-git/v5/plumbing"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrUnknownBranchRole = errors.New("unknown branch role")
+
+var (
+	// Fallback names and patterns when a role is not configured.
+	defaultDevelopBranches     = []string{"develop", "development", "dev"}
+	defaultReleasePatterns     = []string{"release/*", "release-*", "releases/*"}
+	defaultEnvironmentBranches = []string{"staging", "stage", "production", "prod", "preprod", "qa"}
+	defaultHotfixPatterns      = []string{"hotfix/*", "hotfix-*", "hotfixes/*"}
+)
+
+// BranchRole is the role of a branch in the workflow.
+type BranchRole int
+
+const (
+	// FeatureRole is any short lived branch without another role.
+	FeatureRole BranchRole = iota
+	PrimaryRole
+	DevelopRole
+	ReleaseRole
+	EnvironmentRole
+	HotfixRole
+)
+
+// BranchRole string lookup.
+func (r BranchRole) String() string {
+	return [...]string{
+		"feature",
+		"primary",
+		"develop",
+		"release",
+		"environment",
+		"hotfix",
+	}[r]
+}
+
+// MarshalText uses the role name in JSON reports.
+func (r BranchRole) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText parses the role name, used when importing archived models.
+func (r *BranchRole) UnmarshalText(text []byte) error {
+	for role := FeatureRole; role <= HotfixRole; role++ {
+		if role.String() == string(text) {
+			*r = role
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownBranchRole, text)
+}
+
+// BranchRoles are the resolved roles of branches, either configured or inferred.
+type BranchRoles struct {
+	Primary     string   `json:"primary"`
+	Develop     string   `json:"develop"`     // Empty when the primary branch is also the integration branch.
+	Release     []string `json:"release"`     // Release branch names and glob patterns.
+	Environment []string `json:"environment"` // Environment branch names and glob patterns.
+	Hotfix      []string `json:"hotfix"`      // Hotfix branch names and glob patterns.
+
+	// Roles that were inferred by heuristics rather than configured.
+	Inferred map[BranchRole]bool `json:"inferred"`
+}
+
+// Role finds the role of a branch by name.
+func (r *BranchRoles) Role(name string) BranchRole {
+	switch {
+	case r == nil:
+		return FeatureRole
+	case name == r.Primary:
+		return PrimaryRole
+	case r.Develop != "" && name == r.Develop:
+		return DevelopRole
+	case matchAny(r.Release, name):
+		return ReleaseRole
+	case matchAny(r.Environment, name):
+		return EnvironmentRole
+	case matchAny(r.Hotfix, name):
+		return HotfixRole
+	default:
+		return FeatureRole
+	}
+}
+
+// Integration is the branch feature branches are merged into.
+func (r *BranchRoles) Integration() string {
+	if r == nil {
+		return ""
+	}
+
+	if r.Develop != "" {
+		return r.Develop
+	}
+
+	return r.Primary
+}
+
+// LongLived checks if a branch is expected to live for the lifetime of the repository.
+func (r *BranchRoles) LongLived(name string) bool {
+	switch r.Role(name) {
+	case PrimaryRole, DevelopRole, ReleaseRole, EnvironmentRole:
+		return true
+	case FeatureRole, HotfixRole:
+		return false
+	default:
+		return false
+	}
+}
+
+// AssignBranchRoles resolves the branch roles from the config, inferring roles that are not configured.
+// MainGraph is set to the integration branch and ReleaseGraph to the release branch.
+func (em *EnrichedModel) AssignBranchRoles(cfg config.BranchRoles) error {
+	roles := &BranchRoles{
+		Primary:     cfg.Primary,
+		Develop:     cfg.Develop,
+		Release:     cfg.Release,
+		Environment: cfg.Environment,
+		Hotfix:      cfg.Hotfix,
+		Inferred:    make(map[BranchRole]bool),
+	}
+
+	if roles.Primary == "" {
+		roles.Primary = em.defaultBranch()
+		roles.Inferred[PrimaryRole] = true
+	}
+
+	if roles.Develop == "" {
+		roles.Develop = em.inferDevelopBranch(roles.Primary)
+		roles.Inferred[DevelopRole] = true
+	}
+
+	if roles.Develop == roles.Primary {
+		roles.Develop = ""
+	}
+
+	if len(roles.Environment) == 0 {
+		roles.Environment = em.existingBranches(defaultEnvironmentBranches)
+		roles.Inferred[EnvironmentRole] = true
+	}
+
+	if len(roles.Hotfix) == 0 {
+		roles.Hotfix = defaultHotfixPatterns
+		roles.Inferred[HotfixRole] = true
+	}
+
+	if len(roles.Release) == 0 {
+		roles.Release = defaultReleasePatterns
+		roles.Inferred[ReleaseRole] = true
+
+		// The most recent tags are usually on the release branch.
+		if tagged := em.taggedBranch(roles); tagged != "" {
+			roles.Release = append([]string{tagged}, roles.Release...)
+		}
+	}
+
+	em.Roles = roles
+
+	return em.assignGraphs()
+}
+
+// assignGraphs builds the main and release branch graphs from the roles.
+func (em *EnrichedModel) assignGraphs() error {
+	integration := em.Roles.Integration()
+	if em.MainGraph == nil || em.MainGraph.BranchName != integration {
+		graph, err := em.branchGraph(integration)
+		switch {
+		case errors.Is(err, ErrBranchNotFound):
+			// Keep the graph of the checked out branch.
+			log.Warnf("integration branch %s not found", integration)
+		case err != nil:
+			return fmt.Errorf("failed to create integration branch graph: %w", err)
+		default:
+			em.MainGraph = graph
+		}
+	}
+
+	// With a develop branch the primary branch is released from.
+	release := ""
+	if em.Roles.Develop != "" {
+		release = em.Roles.Primary
+	} else {
+		for _, b := range em.sortedBranchNames() {
+			if em.Roles.Role(b) == ReleaseRole {
+				release = b
+
+				break
+			}
+		}
+	}
+
+	switch {
+	case release == "":
+		em.ReleaseGraph = nil
+	case em.ReleaseGraph == nil || em.ReleaseGraph.BranchName != release:
+		graph, err := em.branchGraph(release)
+		switch {
+		case errors.Is(err, ErrBranchNotFound):
+			log.Warnf("release branch %s not found", release)
+			em.ReleaseGraph = nil
+		case err != nil:
+			return fmt.Errorf("failed to create release branch graph: %w", err)
+		default:
+			em.ReleaseGraph = graph
+		}
+	}
+
+	return nil
+}
+
+// branchGraph creates the graph of a branch by name.
+// Imported models have no repository, their graphs are built from the commits instead.
+func (em *EnrichedModel) branchGraph(name string) (*local.BranchGraph, error) {
+	for _, branch := range em.Branches {
+		if branch.Name != name {
+			continue
+		}
+
+		if em.Repository == nil {
+			graph := em.commitGraph(branch.Head)
+			graph.BranchName = name
+
+			return graph, nil
+		}
+
+		commit, err := em.Repository.CommitObject(plumbing.NewHash(branch.Head.Hash.HexString()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit object: %w", err)
+		}
+
+		graph := local.FetchBranchGraph(commit)
+		graph.BranchName = name
+
+		return graph, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrBranchNotFound, name)
+}
+
+// commitGraph creates a graph from the head following the parents in the commits of the model.
+// Parents outside of the model, such as the empty tree of root commits, are left out.
+func (em *EnrichedModel) commitGraph(head local.Commit) *local.BranchGraph {
+	commits := make(map[string]local.Commit, len(em.Commits))
+	for _, c := range em.Commits {
+		commits[c.Hash.HexString()] = c
+	}
+
+	node := func(c local.Commit) *local.CommitGraph {
+		return &local.CommitGraph{Hash: c.Hash.HexString(), Author: c.Author, Committer: c.Committer}
+	}
+
+	headGraph := node(head)
+	nodes := map[string]*local.CommitGraph{headGraph.Hash: headGraph}
+	stack := []local.Commit{head}
+	for len(stack) != 0 {
+		n := len(stack) - 1
+		c := stack[n]
+		stack = stack[:n]
+
+		cg := nodes[c.Hash.HexString()]
+		for _, h := range c.ParentHashes {
+			hex := h.HexString()
+			if parent, ok := nodes[hex]; ok {
+				cg.ParentCommits = append(cg.ParentCommits, parent)
+
+				continue
+			}
+
+			pc, ok := commits[hex]
+			if !ok {
+				continue
+			}
+
+			parent := node(pc)
+			nodes[hex] = parent
+			cg.ParentCommits = append(cg.ParentCommits, parent)
+			stack = append(stack, pc)
+		}
+	}
+
+	return &local.BranchGraph{Head: headGraph}
+}
+
+// defaultBranch is the branch checked out by the repository, usually the GitHub default branch.
+func (em *EnrichedModel) defaultBranch() string {
+	if em.DefaultBranch != "" {
+		return em.DefaultBranch
+	}
+
+	if em.MainGraph != nil && em.MainGraph.BranchName != "" {
+		return em.MainGraph.BranchName
+	}
+
+	if em.Repository != nil {
+		if ref, err := em.Repository.Head(); err == nil {
+			return ref.Name().Short()
+		}
+	}
+
+	return ""
+}
+
+// inferDevelopBranch finds the branch with the most merged pull requests, or a conventionally named one.
+func (em *EnrichedModel) inferDevelopBranch(primary string) string {
+	if dev := findDevBranchByPR(em.PullRequests); dev != "" && dev != primary && em.hasBranch(dev) {
+		return dev
+	}
+
+	if existing := em.existingBranches(defaultDevelopBranches); len(existing) != 0 {
+		return existing[0]
+	}
+
+	return ""
+}
+
+// taggedBranch finds a branch other than the primary and develop branches whose head is tagged.
+func (em *EnrichedModel) taggedBranch(roles *BranchRoles) string {
+	tagged := make(map[local.Hash]struct{})
+	for _, tag := range em.Tags {
+		tagged[tag.Head.Hash] = struct{}{}
+	}
+
+	for _, branch := range em.Branches {
+		if branch.Name == roles.Primary || branch.Name == roles.Develop {
+			continue
+		}
+
+		if _, ok := tagged[branch.Head.Hash]; ok {
+			return branch.Name
+		}
+	}
+
+	return ""
+}
+
+// existingBranches filters names to those of existing branches, keeping the order of names.
+func (em *EnrichedModel) existingBranches(names []string) []string {
+	existing := []string{}
+	for _, name := range names {
+		if em.hasBranch(name) {
+			existing = append(existing, name)
+		}
+	}
+
+	return existing
+}
+
+func (em *EnrichedModel) hasBranch(name string) bool {
+	for _, branch := range em.Branches {
+		if branch.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BranchCommits finds the commits reachable from the head of the branch, nil when the branch does not exist.
+func (em *EnrichedModel) BranchCommits(name string) map[local.Hash]struct{} {
+	for _, branch := range em.Branches {
+		if branch.Name != name {
+			continue
+		}
+
+		commits := make(map[string]*local.Commit, len(em.Commits))
+		for i := range em.Commits {
+			commits[em.Commits[i].Hash.HexString()] = &em.Commits[i]
+		}
+
+		return ancestors(commits, []local.Hash{branch.Head.Hash})
+	}
+
+	return nil
+}
+
+// FirstParentHistory follows the first parents of the main graph, the commits made or merged on the branch itself.
+func (em *EnrichedModel) FirstParentHistory() []local.Hash {
+	if em.MainGraph == nil {
+		return nil
+	}
+
+	var history []local.Hash
+	for node := em.MainGraph.Head; node != nil; {
+		h, err := local.NewHash(node.Hash)
+		if err != nil {
+			break
+		}
+		history = append(history, h)
+
+		if len(node.ParentCommits) == 0 {
+			break
+		}
+		node = node.ParentCommits[0]
+	}
+
+	return history
+}
+
+func (em *EnrichedModel) sortedBranchNames() []string {
+	names := make([]string, 0, len(em.Branches))
+	for _, branch := range em.Branches {
+		names = append(names, branch.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// findDevBranchByPR finds the dev branch by the most number of PR merged into the branch.
+func findDevBranchByPR(prs []*remote.PullRequest) string {
+	count := map[string]int{}
+	for _, pr := range prs {
+		if !pr.Merged {
+			continue
+		}
+
+		count[pr.BaseRefName]++
+	}
+
+	max := 0
+	var devBranch string
+	for branch, c := range count {
+		if c > max || (c == max && branch < devBranch) {
+			max = c
+			devBranch = branch
+		}
+	}
+
+	return devBranch
+}
+
+// matchAny checks if the name matches any of the names or glob patterns.
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		} else if err != nil {
+			log.Warnf("bad branch pattern %s: %v", pattern, err)
+		}
+	}
+
+	return false
+}
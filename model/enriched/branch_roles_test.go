@@ -0,0 +1,110 @@
+package enriched
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func branches(names ...string) []local.Branch {
+	bs := make([]local.Branch, len(names))
+	for i, name := range names {
+		bs[i] = local.Branch{Name: name, Head: local.Commit{Hash: hash(byte(i + 1))}}
+	}
+
+	return bs
+}
+
+func TestAssignBranchRoles(t *testing.T) {
+	merged := func(base string) *remote.PullRequest {
+		return &remote.PullRequest{Merged: true, BaseRefName: base}
+	}
+
+	tests := []struct {
+		name     string
+		branches []local.Branch
+		prs      []*remote.PullRequest
+		cfg      config.BranchRoles
+		want     map[string]BranchRole
+		develop  string
+	}{
+		{
+			"github_flow",
+			branches("main", "feature/login", "hotfix/crash"),
+			[]*remote.PullRequest{merged("main")},
+			config.BranchRoles{},
+			map[string]BranchRole{"main": PrimaryRole, "feature/login": FeatureRole, "hotfix/crash": HotfixRole},
+			"",
+		},
+		{
+			"inferred_develop",
+			branches("main", "develop", "release/1.0", "staging", "production", "feature/login"),
+			[]*remote.PullRequest{merged("develop"), merged("develop"), merged("main")},
+			config.BranchRoles{},
+			map[string]BranchRole{
+				"main":          PrimaryRole,
+				"develop":       DevelopRole,
+				"release/1.0":   ReleaseRole,
+				"staging":       EnvironmentRole,
+				"production":    EnvironmentRole,
+				"feature/login": FeatureRole,
+			},
+			"develop",
+		},
+		{
+			"configured",
+			branches("trunk", "integration", "rc-2", "live", "fix-1", "dev"),
+			nil,
+			config.BranchRoles{
+				Primary:     "trunk",
+				Develop:     "integration",
+				Release:     []string{"rc-*"},
+				Environment: []string{"live"},
+				Hotfix:      []string{"fix-*"},
+			},
+			map[string]BranchRole{
+				"trunk":       PrimaryRole,
+				"integration": DevelopRole,
+				"rc-2":        ReleaseRole,
+				"live":        EnvironmentRole,
+				"fix-1":       HotfixRole,
+				"dev":         FeatureRole,
+			},
+			"integration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := NewEnrichedModel(
+				local.GitModel{Branches: tt.branches, MainGraph: &local.BranchGraph{BranchName: tt.branches[0].Name}},
+				remote.RemoteModel{PullRequests: tt.prs},
+			)
+			if err := em.AssignBranchRoles(tt.cfg); err != nil {
+				t.Fatalf("AssignBranchRoles() error = %v", err)
+			}
+
+			for name, want := range tt.want {
+				if got := em.Roles.Role(name); got != want {
+					t.Errorf("Role(%s) = %v, want %v", name, got, want)
+				}
+			}
+
+			if em.Roles.Develop != tt.develop {
+				t.Errorf("Develop = %v, want %v", em.Roles.Develop, tt.develop)
+			}
+
+			if configured := tt.cfg.Primary != ""; em.Roles.Inferred[PrimaryRole] == configured {
+				t.Errorf("Inferred[primary] = %v, want %v", em.Roles.Inferred[PrimaryRole], !configured)
+			}
+		})
+	}
+}
+
+func TestBranchRolesNil(t *testing.T) {
+	var roles *BranchRoles
+	if roles.Role("main") != FeatureRole || roles.LongLived("main") || roles.Integration() != "" {
+		t.Errorf("nil BranchRoles should treat every branch as a feature branch")
+	}
+}
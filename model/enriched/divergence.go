@@ -0,0 +1,97 @@
+package enriched
+
+import (
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+)
+
+// Divergence of a branch from the branch it is merged into.
+type Divergence struct {
+	Branch    string
+	Base      string     // Integration branch, or the primary branch for the integration branch itself.
+	Ahead     int        // Commits on the branch that are not on the base.
+	Behind    int        // Commits on the base that are not on the branch.
+	ForkPoint local.Hash // Latest commit shared with the base, zero when the histories are unrelated.
+	Forked    time.Time  // Commit time of the fork point.
+}
+
+// Age is the time since the branch forked from its base.
+func (d Divergence) Age() time.Duration {
+	if d.Forked.IsZero() {
+		return 0
+	}
+
+	return time.Since(d.Forked)
+}
+
+// Divergences computes the divergence of every branch except the primary branch, ordered by branch name.
+func (em *EnrichedModel) Divergences() []Divergence {
+	if em.Roles == nil {
+		return nil
+	}
+
+	commits := make(map[string]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[em.Commits[i].Hash.HexString()] = &em.Commits[i]
+	}
+
+	heads := make(map[string]local.Hash, len(em.Branches))
+	for _, branch := range em.Branches {
+		heads[branch.Name] = branch.Head.Hash
+	}
+
+	reachable := make(map[string]map[local.Hash]struct{})
+	reach := func(name string) map[local.Hash]struct{} {
+		if _, ok := reachable[name]; !ok {
+			reachable[name] = ancestors(commits, []local.Hash{heads[name]})
+		}
+
+		return reachable[name]
+	}
+
+	var divergences []Divergence
+
+	for _, name := range em.sortedBranchNames() {
+		base := em.Roles.Integration()
+		if name == base {
+			base = em.Roles.Primary
+		}
+
+		if name == em.Roles.Primary {
+			continue
+		}
+
+		if _, ok := heads[base]; !ok {
+			continue
+		}
+
+		d := Divergence{Branch: name, Base: base}
+		branchCommits, baseCommits := reach(name), reach(base)
+
+		for h := range branchCommits {
+			if _, ok := baseCommits[h]; !ok {
+				d.Ahead++
+
+				continue
+			}
+
+			// The fork point is the latest shared commit.
+			when := commits[h.HexString()].Committer.When
+			if d.Forked.IsZero() || when.After(d.Forked) ||
+				(when.Equal(d.Forked) && h.HexString() > d.ForkPoint.HexString()) {
+				d.ForkPoint, d.Forked = h, when
+			}
+		}
+
+		for h := range baseCommits {
+			if _, ok := branchCommits[h]; !ok {
+				d.Behind++
+			}
+		}
+
+		divergences = append(divergences, d)
+	}
+
+	return divergences
+}
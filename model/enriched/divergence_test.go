@@ -0,0 +1,58 @@
+package enriched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestDivergences(t *testing.T) {
+	when := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	at := func(c local.Commit, hours int) local.Commit {
+		c.Committer.When = when.Add(time.Duration(hours) * time.Hour)
+
+		return c
+	}
+
+	// 1 - 2 - 3 - 4   main
+	//      \
+	//       5 - 6     feature
+	commits := []local.Commit{
+		at(commit(6, "", 5), 6),
+		at(commit(5, "", 2), 5),
+		at(commit(4, "", 3), 4),
+		at(commit(3, "", 2), 3),
+		at(commit(2, "", 1), 2),
+		at(commit(1, ""), 1),
+	}
+
+	em := NewEnrichedModel(local.GitModel{
+		Commits: commits,
+		Branches: []local.Branch{
+			{Name: "main", Head: commits[2]},
+			{Name: "feature", Head: commits[0]},
+			{Name: "merged", Head: commits[3]},
+		},
+	}, remote.RemoteModel{})
+	if err := em.AssignBranchRoles(config.BranchRoles{Primary: "main"}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	want := []Divergence{
+		{Branch: "feature", Base: "main", Ahead: 2, Behind: 2, ForkPoint: hash(2), Forked: when.Add(2 * time.Hour)},
+		{Branch: "merged", Base: "main", Ahead: 0, Behind: 1, ForkPoint: hash(3), Forked: when.Add(3 * time.Hour)},
+	}
+	got := em.Divergences()
+	if len(got) != len(want) {
+		t.Fatalf("Divergences() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Divergences()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
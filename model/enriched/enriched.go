@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/Git-Gopher/go-gopher/identity"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
-	"github.com/Git-Gopher/go-gopher/utils"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -19,6 +20,8 @@ import (
 var (
 	ErrPullRequestNumber = errors.New("could not fetch pull request number from env (PR_NUMBER)")
 	ErrFindPullRequest   = errors.New("could not find pull request from scraped repo given pull request number")
+	ErrBranchNotFound    = errors.New("could not find branch")
+	ErrNoRepository      = errors.New("repository is not available for imported models")
 )
 
 type EnrichedModel struct {
@@ -32,7 +35,13 @@ type EnrichedModel struct {
 	BranchMatrix    []*local.BranchMatrix `json:"-"` // Matrix representation by comparing branches
 	LocalCommitters []local.Committer
 	Tags            []*local.Tag
-	ReleaseGraph    *local.BranchGraph `json:"-"` // Graph representation of commits in the release branch
+	ReleaseGraph    *local.BranchGraph      `json:"-"` // Graph representation of commits in the release branch
+	Roles           *BranchRoles            // Roles of the branches, set by AssignBranchRoles
+	DefaultBranch   string                  // Branch checked out when the model was created
+	Mailmap         []identity.MailmapEntry `json:"-"` // Entries of the repository .mailmap
+	LFSPatterns     []string                // Patterns of the .gitattributes tracked by Git LFS
+	IgnorePatterns  []local.IgnorePattern   // Patterns of the .gitignore files
+	Templates       map[string][]string     `json:"-"` // Pull request templates by base commit, see PullRequestTemplates
 
 	// Not all functionality has been ported from go-git.
 	Repository *git.Repository
@@ -41,11 +50,21 @@ type EnrichedModel struct {
 	PullRequests     []*remote.PullRequest
 	Issues           []*remote.Issue
 	GithubCommitters []remote.Committer
+
+	// Linkage between commits and the pull requests that introduced them.
+	CommitPullRequests map[local.Hash][]PullRequestLink `json:"-"`
+	PullRequestCommits map[int][]local.Hash             `json:"-"` // pull request number => commits
+
+	// Merge strategy of each merged pull request, by pull request number.
+	PullRequestStrategies map[int]MergeStrategy `json:"-"`
+
+	// Merge changes fetched from the repository, see MergeChanges.
+	mergeChanges map[local.Hash][]local.MergeChange
 }
 
 // Create an enriched model by merging the local and GitHub model.
 func NewEnrichedModel(local local.GitModel, github remote.RemoteModel) *EnrichedModel {
-	return &EnrichedModel{
+	em := &EnrichedModel{
 		// local.GitModel
 		Commits:         local.Commits,
 		Branches:        local.Branches,
@@ -54,6 +73,8 @@ func NewEnrichedModel(local local.GitModel, github remote.RemoteModel) *Enriched
 		LocalCommitters: local.Committer,
 		Repository:      local.Repository,
 		Tags:            local.Tags,
+		LFSPatterns:     local.LFSPatterns,
+		IgnorePatterns:  local.IgnorePatterns,
 
 		// remote.RemoteModel
 		Name:             github.Name,
@@ -63,25 +84,40 @@ func NewEnrichedModel(local local.GitModel, github remote.RemoteModel) *Enriched
 		Owner:            github.Owner,
 		GithubCommitters: github.Committers,
 	}
+
+	if local.MainGraph != nil {
+		em.DefaultBranch = local.MainGraph.BranchName
+	}
+
+	mailmap, err := readMailmap(local.Repository)
+	if err != nil {
+		log.Warnf("Error reading mailmap: %v", err)
+	}
+	em.Mailmap = mailmap
+
+	em.Templates = readPullRequestTemplates(local.Repository, github.PullRequests)
+
+	em.LinkPullRequests()
+
+	return em
 }
 
-func PopulateAuthors( //nolint: ireturn
+// ResolveIdentities merges manual mappings, GitHub committers, pull request and issue authors,
+// the repository .mailmap and local commit signatures into canonical people.
+func ResolveIdentities( //nolint: ireturn
 	enriched *EnrichedModel,
-	manualUsers ...struct{ email, login string },
-) utils.Authors {
-	authors := utils.NewAuthors()
+	mappings ...identity.Mapping,
+) identity.Resolver {
+	resolver := identity.NewResolver()
 
 	// Add manual committers.
-	for _, m := range manualUsers {
-		err := authors.Add(m.login, m.email)
-		if err != nil {
-			log.Fatalf("Error adding manual user: %v", err)
-		}
+	if err := identity.AddMappings(resolver, mappings); err != nil {
+		log.Fatalf("Error adding manual user: %v", err)
 	}
 
 	// enriched model not available.
-	if enriched == nil || enriched.GithubCommitters == nil {
-		return authors
+	if enriched == nil {
+		return resolver
 	}
 
 	unavailableMap := make(map[string]struct{})    // map of unavailable committers.
@@ -89,40 +125,61 @@ func PopulateAuthors( //nolint: ireturn
 
 	for _, committer := range enriched.GithubCommitters {
 		commitMap[committer.CommitId] = committer
-		if authors.Check(committer.Email) {
-			continue
-		}
 
 		// Login is not always available.
-		if committer.Login == "" {
+		login, source := committer.Login, identity.GitHubCommitter
+		if login == "" {
+			login, source = identity.NoreplyLogin(committer.Email), identity.Noreply
+		}
+
+		if login == "" {
 			unavailableMap[committer.Email] = struct{}{}
 
 			continue
 		}
 
-		err := authors.Add(committer.Login, committer.Email)
-		if err != nil {
-			log.Fatalf("Error adding committer: %v", err)
+		if err := resolver.Add(login, committer.Email, source); err != nil {
+			log.Warnf("Error adding committer: %v", err)
 		}
 	}
 
-	for _, committer := range enriched.LocalCommitters {
-		remoteCommitter, ok := commitMap[committer.CommitId]
-		if !ok {
-			continue
+	addAuthor := func(author *remote.Author, source identity.Source) {
+		if author == nil || author.Login == "" || author.Email == "" {
+			return
 		}
 
-		if committer.Email == remoteCommitter.Email {
-			continue
+		if err := resolver.Add(author.Login, author.Email, source); err != nil {
+			log.Warnf("Error adding author: %v", err)
 		}
+	}
 
-		if authors.Check(committer.Email) {
-			continue
+	for _, pr := range enriched.PullRequests {
+		addAuthor(pr.Author, identity.PullRequestAuthor)
+		addAuthor(pr.MergedBy, identity.PullRequestAuthor)
+	}
+
+	for _, issue := range enriched.Issues {
+		addAuthor(issue.Author, identity.IssueAuthor)
+	}
+
+	identity.AddMailmap(resolver, enriched.Mailmap)
+
+	for _, commit := range enriched.Commits {
+		// Local signatures of commits GitHub knows about belong to the same login.
+		if remoteCommitter, ok := commitMap[commit.Hash.HexString()]; ok && remoteCommitter.Login != "" {
+			for _, sig := range []local.Signature{commit.Author, commit.Committer} {
+				if sig.Email != "" && !strings.EqualFold(sig.Email, remoteCommitter.Email) && !resolver.Check(sig.Email) {
+					_ = resolver.Add(remoteCommitter.Login, sig.Email, identity.GitHubCommitter)
+				}
+			}
 		}
 
-		err := authors.Add(remoteCommitter.Login, committer.Email)
-		if err != nil {
-			log.Fatalf("Error adding committer: %v", err)
+		for _, sig := range []local.Signature{commit.Author, commit.Committer} {
+			if _, err := resolver.Link(sig.Email, sig.Name); err != nil {
+				unavailableMap[sig.Email] = struct{}{}
+			} else {
+				delete(unavailableMap, sig.Email)
+			}
 		}
 	}
 
@@ -133,7 +190,38 @@ func PopulateAuthors( //nolint: ireturn
 
 	log.Println("Unavailable authors:", unavailable)
 
-	return authors
+	return resolver
+}
+
+// readMailmap reads the .mailmap file from the head of the repository if it exists.
+func readMailmap(repo *git.Repository) ([]identity.MailmapEntry, error) {
+	if repo == nil {
+		return nil, nil
+	}
+
+	ref, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find head reference: %w", err)
+	}
+
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find head commit: %w", err)
+	}
+
+	file, err := commit.File(".mailmap")
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find mailmap: %w", err)
+	}
+
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mailmap: %w", err)
+	}
+
+	return identity.ParseMailmap(strings.NewReader(contents))
 }
 
 // Find the current PR that the action is running on. Requires PR_NUMBER is set in env by action.
@@ -169,6 +257,10 @@ func (em *EnrichedModel) FindCurrentPR() (*remote.PullRequest, error) {
 
 // Find merging commits by querying GitHub's graphql api with oids of two branches.
 func (em *EnrichedModel) FindMergingCommits(pr *remote.PullRequest) ([]local.Hash, error) {
+	if em.Repository == nil {
+		return nil, ErrNoRepository
+	}
+
 	// Collect commits belonging to the source and target branches.
 	sourceCommitHashes := make(map[local.Hash]struct{})
 	targetCommitHashes := make(map[local.Hash]struct{})
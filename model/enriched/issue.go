@@ -0,0 +1,64 @@
+package enriched
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+)
+
+// issueReferencePattern matches issue references such as "#12" or "Fixes #12", but not references to other
+// repositories such as "owner/repo#12".
+var issueReferencePattern = regexp.MustCompile(`(?:^|[\s(\[,;:])#(\d+)\b`)
+
+// IssueReferences finds the issue and pull request numbers referenced by a message, in order without duplicates.
+func IssueReferences(message string) []int {
+	var numbers []int
+	seen := make(map[int]bool)
+
+	for _, match := range issueReferencePattern.FindAllStringSubmatch(message, -1) {
+		n, err := strconv.Atoi(match[1])
+		if err != nil || n == 0 || seen[n] {
+			continue
+		}
+		seen[n] = true
+		numbers = append(numbers, n)
+	}
+
+	return numbers
+}
+
+// IssueCommits maps issue and pull request numbers to the commits referencing them in their message.
+func (em *EnrichedModel) IssueCommits() map[int][]local.Hash {
+	commits := make(map[int][]local.Hash)
+	for _, c := range em.Commits {
+		for _, n := range IssueReferences(c.Message) {
+			commits[n] = append(commits[n], c.Hash)
+		}
+	}
+
+	return commits
+}
+
+// LinkedIssues are the numbers of issues linked to the work that resolved them: closed by a commit or pull request,
+// referenced by a commit message, or referenced as a closing issue of a pull request.
+func (em *EnrichedModel) LinkedIssues() map[int]bool {
+	linked := make(map[int]bool)
+	for _, issue := range em.Issues {
+		if issue.ClosedByCommit != "" || issue.ClosedByPullRequest != 0 {
+			linked[issue.Number] = true
+		}
+	}
+
+	for n := range em.IssueCommits() {
+		linked[n] = true
+	}
+
+	for _, pr := range em.PullRequests {
+		for _, issue := range pr.ClosingIssues {
+			linked[issue.Number] = true
+		}
+	}
+
+	return linked
+}
@@ -0,0 +1,53 @@
+package enriched
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestIssueReferences(t *testing.T) {
+	tests := []struct {
+		message string
+		want    []int
+	}{
+		{"Fix login #12", []int{12}},
+		{"Fixes #12, closes #3 and #12", []int{12, 3}},
+		{"Add signup (#7)\n\nRefs: #8", []int{7, 8}},
+		{"See Git-Gopher/tests#4 and issue#5", nil},
+		{"Use colour #fff and #0", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if got := IssueReferences(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IssueReferences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkedIssues(t *testing.T) {
+	em := NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{commit(1, "Fix login\n\nFixes #1")},
+	}, remote.RemoteModel{
+		Issues: []*remote.Issue{
+			{Number: 1},
+			{Number: 2, ClosedByPullRequest: 5},
+			{Number: 3},
+			{Number: 4},
+		},
+		PullRequests: []*remote.PullRequest{
+			{Number: 5},
+			{Number: 6, ClosingIssues: []*remote.Issue{{Number: 3}}},
+		},
+	})
+
+	linked := em.LinkedIssues()
+	for n, want := range map[int]bool{1: true, 2: true, 3: true, 4: false} {
+		if linked[n] != want {
+			t.Errorf("LinkedIssues()[%d] = %v, want %v", n, linked[n], want)
+		}
+	}
+}
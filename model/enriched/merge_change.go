@@ -0,0 +1,42 @@
+package enriched
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+)
+
+// Guards the merge changes of every model, as models are copied by value a lock cannot be part of the model.
+var mergeChangesMu sync.Mutex
+
+// MergeChanges finds the changes of the merge commit to every parent, nil for other commits. The changes are
+// fetched from the repository on first use, models without a repository use the changes of their archive.
+func (em *EnrichedModel) MergeChanges(commit *local.Commit) ([]local.MergeChange, error) {
+	if em.Repository == nil || len(commit.ParentHashes) < 2 {
+		return commit.MergeChanges, nil
+	}
+
+	mergeChangesMu.Lock()
+	changes, ok := em.mergeChanges[commit.Hash]
+	mergeChangesMu.Unlock()
+
+	if ok {
+		return changes, nil
+	}
+
+	changes, err := local.FetchMergeChanges(em.Repository, commit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch merge changes of %s: %w", commit.Hash.HexString(), err)
+	}
+
+	mergeChangesMu.Lock()
+	defer mergeChangesMu.Unlock()
+
+	if em.mergeChanges == nil {
+		em.mergeChanges = make(map[local.Hash][]local.MergeChange)
+	}
+	em.mergeChanges[commit.Hash] = changes
+
+	return changes, nil
+}
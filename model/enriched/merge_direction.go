@@ -0,0 +1,181 @@
+package enriched
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+)
+
+var ErrUnknownBackMergePolicy = errors.New("unknown back merge policy")
+
+// MergeDirection is the direction of a merge between the integration and feature branches.
+type MergeDirection int
+
+const (
+	// UnknownDirection is used when the branches of a merge can not be found.
+	UnknownDirection MergeDirection = iota
+	// ForwardMerge merges a feature branch into a long lived branch.
+	ForwardMerge
+	// BackMerge merges a long lived branch into a feature branch, instead of rebasing the feature branch.
+	BackMerge
+	// CrossMerge merges a feature branch into another feature branch.
+	CrossMerge
+	// LongLivedMerge merges long lived branches, eg: develop into main or main back into develop.
+	LongLivedMerge
+)
+
+// MergeDirection string lookup.
+func (d MergeDirection) String() string {
+	return [...]string{
+		"unknown",
+		"forward",
+		"back",
+		"cross",
+		"long-lived",
+	}[d]
+}
+
+// BackMergePolicy is how back merges are reported.
+type BackMergePolicy int
+
+const (
+	// AllowBackMerges does not report back merges.
+	AllowBackMerges BackMergePolicy = iota
+	// SuggestBackMerges reports back merges as suggestions.
+	SuggestBackMerges
+	// FlagBackMerges reports back merges as violations.
+	FlagBackMerges
+)
+
+// BackMergePolicy string lookup, also used as the config value.
+func (p BackMergePolicy) String() string {
+	return [...]string{
+		"allow",
+		"suggest",
+		"flag",
+	}[p]
+}
+
+// ParseBackMergePolicy parses a configured back merge policy. Empty parses as AllowBackMerges.
+func ParseBackMergePolicy(s string) (BackMergePolicy, error) {
+	switch strings.ToLower(s) {
+	case "", AllowBackMerges.String():
+		return AllowBackMerges, nil
+	case SuggestBackMerges.String():
+		return SuggestBackMerges, nil
+	case FlagBackMerges.String():
+		return FlagBackMerges, nil
+	default:
+		return AllowBackMerges, fmt.Errorf("%w: %s", ErrUnknownBackMergePolicy, s)
+	}
+}
+
+// mergeMessagePattern matches the messages git writes for merges, eg:
+// "Merge branch 'main' into feature" or "Merge remote-tracking branch 'origin/main'".
+var mergeMessagePattern = regexp.MustCompile(`^Merge (?:remote-tracking )?branch '([^']+)'(?: of \S+)?(?: into (\S+))?`)
+
+// Merge is a merge commit classified by the branches it merged.
+type Merge struct {
+	Commit    local.Hash
+	Source    string // Branch that was merged, empty when not known.
+	Target    string // Branch that was merged into, empty when not known.
+	Direction MergeDirection
+}
+
+// Merges classifies the direction of every merge commit. The branches are found from the pull request that
+// created the merge, or the message git writes for merges. Otherwise the first parent history of the long lived
+// branches tells whether the merge was made on a long lived branch, and whether the merged commit came from one.
+func (em *EnrichedModel) Merges() []Merge {
+	commits := make(map[string]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[em.Commits[i].Hash.HexString()] = &em.Commits[i]
+	}
+
+	// Commits made on or merged into long lived branches.
+	longLived := make(map[local.Hash]bool)
+	for _, branch := range em.Branches {
+		if !em.Roles.LongLived(branch.Name) {
+			continue
+		}
+
+		for h := branch.Head.Hash; !longLived[h]; {
+			c, ok := commits[h.HexString()]
+			if !ok {
+				break
+			}
+			longLived[h] = true
+
+			if len(c.ParentHashes) == 0 {
+				break
+			}
+			h = c.ParentHashes[0]
+		}
+	}
+
+	var merges []Merge
+
+	for i := range em.Commits {
+		c := &em.Commits[i]
+		if len(c.ParentHashes) < 2 {
+			continue
+		}
+
+		m := Merge{Commit: c.Hash}
+		for _, l := range em.CommitPullRequests[c.Hash] {
+			if l.Reason == LinkMergeCommit {
+				m.Source, m.Target = l.PullRequest.HeadRefName, l.PullRequest.BaseRefName
+			}
+		}
+
+		if m.Source == "" {
+			if match := mergeMessagePattern.FindStringSubmatch(c.Message); match != nil {
+				m.Source, m.Target = strings.TrimPrefix(match[1], "origin/"), match[2]
+			}
+		}
+
+		var sourceLongLived, targetLongLived bool
+		switch {
+		case m.Source != "" && m.Target != "":
+			sourceLongLived, targetLongLived = em.Roles.LongLived(m.Source), em.Roles.LongLived(m.Target)
+		case m.Source != "":
+			// Git leaves out the target when merging into the primary branch.
+			sourceLongLived, targetLongLived = em.Roles.LongLived(m.Source), longLived[c.Hash]
+		default:
+			sourceLongLived, targetLongLived = longLived[c.ParentHashes[1]], longLived[c.Hash]
+		}
+
+		// Without roles there is no telling long lived branches apart.
+		if em.Roles != nil {
+			m.Direction = mergeDirection(sourceLongLived, targetLongLived)
+		}
+		merges = append(merges, m)
+	}
+
+	return merges
+}
+
+// MergeDirectionDistribution counts the merge commits of each direction.
+func (em *EnrichedModel) MergeDirectionDistribution() map[MergeDirection]int {
+	distribution := make(map[MergeDirection]int)
+	for _, m := range em.Merges() {
+		distribution[m.Direction]++
+	}
+
+	return distribution
+}
+
+func mergeDirection(sourceLongLived, targetLongLived bool) MergeDirection {
+	switch {
+	case sourceLongLived && targetLongLived:
+		return LongLivedMerge
+	case sourceLongLived:
+		return BackMerge
+	case targetLongLived:
+		return ForwardMerge
+	default:
+		return CrossMerge
+	}
+}
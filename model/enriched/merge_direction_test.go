@@ -0,0 +1,83 @@
+package enriched
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/config"
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestMerges(t *testing.T) {
+	// 1 - 2 ------- 5       main
+	//  \   \       /  \
+	//   3 - 4 ----     8    feature, feature-2
+	//    \            /
+	//     ------ 9 - 7
+	commits := []local.Commit{
+		commit(9, "Merge branch 'feature' into feature-2", 7, 3),
+		commit(8, "Merge commit '"+hash(5).HexString()+"'", 9, 5),
+		commit(7, "Start feature 2", 1),
+		commit(5, "Merge pull request #3 from Git-Gopher/feature", 2, 4),
+		commit(4, "Merge remote-tracking branch 'origin/main' into feature", 3, 2),
+		commit(3, "Add feature", 1),
+		commit(2, "Fix typo", 1),
+		commit(1, "Initial commit"),
+	}
+
+	em := NewEnrichedModel(local.GitModel{
+		Commits:  commits,
+		Branches: []local.Branch{{Name: "main", Head: commits[3]}, {Name: "feature-2", Head: commits[1]}},
+	}, remote.RemoteModel{
+		PullRequests: []*remote.PullRequest{{
+			Number:      3,
+			Merged:      true,
+			HeadRefName: "feature",
+			BaseRefName: "main",
+			MergeCommit: hash(5).HexString(),
+		}},
+	})
+
+	if got := em.Merges(); got[0].Direction != UnknownDirection {
+		t.Errorf("Merges() without roles = %v, want %v", got[0].Direction, UnknownDirection)
+	}
+
+	if err := em.AssignBranchRoles(config.BranchRoles{Primary: "main"}); err != nil {
+		t.Fatalf("AssignBranchRoles() error = %v", err)
+	}
+
+	want := []Merge{
+		{Commit: hash(9), Source: "feature", Target: "feature-2", Direction: CrossMerge},
+		{Commit: hash(8), Direction: BackMerge},
+		{Commit: hash(5), Source: "feature", Target: "main", Direction: ForwardMerge},
+		{Commit: hash(4), Source: "main", Target: "feature", Direction: BackMerge},
+	}
+	got := em.Merges()
+	if len(got) != len(want) {
+		t.Fatalf("Merges() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Merges()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	distribution := em.MergeDirectionDistribution()
+	if distribution[BackMerge] != 2 || distribution[ForwardMerge] != 1 || distribution[CrossMerge] != 1 {
+		t.Errorf("MergeDirectionDistribution() = %v", distribution)
+	}
+}
+
+func TestParseBackMergePolicy(t *testing.T) {
+	for _, s := range []string{"", "allow", "suggest", "FLAG"} {
+		if _, err := ParseBackMergePolicy(s); err != nil {
+			t.Errorf("ParseBackMergePolicy(%q) error = %v", s, err)
+		}
+	}
+
+	if _, err := ParseBackMergePolicy("forbid"); !errors.Is(err, ErrUnknownBackMergePolicy) {
+		t.Errorf("ParseBackMergePolicy(forbid) error = %v, want %v", err, ErrUnknownBackMergePolicy)
+	}
+}
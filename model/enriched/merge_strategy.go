@@ -0,0 +1,90 @@
+package enriched
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+var ErrUnknownMergeStrategy = errors.New("unknown merge strategy")
+
+// MergeStrategy is how a pull request was merged into its target branch.
+type MergeStrategy int
+
+const (
+	// UnknownStrategy is used for pull requests that are not merged or whose merge commit is missing locally.
+	UnknownStrategy MergeStrategy = iota
+	// MergeCommitStrategy creates a merge commit with the pull request head as the second parent.
+	MergeCommitStrategy
+	// SquashStrategy combines all pull request commits into a single commit.
+	SquashStrategy
+	// RebaseStrategy replays each pull request commit onto the target branch.
+	RebaseStrategy
+)
+
+// MergeStrategy string lookup, also used as the config value.
+func (s MergeStrategy) String() string {
+	return [...]string{
+		"unknown",
+		"merge",
+		"squash",
+		"rebase",
+	}[s]
+}
+
+// ParseMergeStrategy parses a configured merge strategy. Empty and "any" parse as UnknownStrategy.
+func ParseMergeStrategy(s string) (MergeStrategy, error) {
+	switch strings.ToLower(s) {
+	case "", "any":
+		return UnknownStrategy, nil
+	case MergeCommitStrategy.String():
+		return MergeCommitStrategy, nil
+	case SquashStrategy.String():
+		return SquashStrategy, nil
+	case RebaseStrategy.String():
+		return RebaseStrategy, nil
+	default:
+		return UnknownStrategy, fmt.Errorf("%w: %s", ErrUnknownMergeStrategy, s)
+	}
+}
+
+// MergeStrategy returns the classified merge strategy of a pull request.
+func (em *EnrichedModel) MergeStrategy(pr *remote.PullRequest) MergeStrategy {
+	if pr == nil {
+		return UnknownStrategy
+	}
+
+	return em.PullRequestStrategies[pr.Number]
+}
+
+// MergeStrategyDistribution counts the merged pull requests of each strategy.
+func (em *EnrichedModel) MergeStrategyDistribution() map[MergeStrategy]int {
+	distribution := make(map[MergeStrategy]int)
+	for _, pr := range em.PullRequests {
+		if pr != nil && pr.Merged {
+			distribution[em.MergeStrategy(pr)]++
+		}
+	}
+
+	return distribution
+}
+
+// classifyMergeStrategy uses the merge commit, its parents and the pull request commits
+// to decide how a pull request was merged. rebased are the copies found below the merge commit.
+func classifyMergeStrategy(pr *remote.PullRequest, merge *local.Commit, rebased []local.Hash) MergeStrategy {
+	if len(merge.ParentHashes) > 1 {
+		return MergeCommitStrategy
+	}
+
+	// A rebase copies every pull request commit, keeping the messages. GitHub appends the
+	// pull request number to squash commits, so a single commit with the original message is a rebase.
+	if len(pr.Commits) > 0 && len(rebased) == len(pr.Commits)-1 &&
+		messageHeadline(merge.Message) == pr.Commits[len(pr.Commits)-1].MessageHeadline {
+		return RebaseStrategy
+	}
+
+	return SquashStrategy
+}
@@ -0,0 +1,117 @@
+package enriched
+
+import (
+	"sort"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+// MergedBranch is a branch that was merged but not deleted.
+type MergedBranch struct {
+	Branch      string
+	Head        local.Hash
+	Base        string              // Branch the head is reachable from, or the base of the pull request.
+	PullRequest *remote.PullRequest // Merged pull request of the branch, nil when merged without one.
+}
+
+// MergedBranches finds the short lived branches that were merged, ordered by branch name. A branch is merged when
+// it has a merged pull request whose head is the branch head or is reachable from the primary or integration
+// branch, so squash and rebase merged branches are found, or when a merge commit of the primary or integration
+// branch merged its head. Branches with commits pushed after their pull request was merged are kept. Branches
+// even with the base without either, eg: a branch just created from the primary branch, are not merged.
+func (em *EnrichedModel) MergedBranches() []MergedBranch {
+	if em.Roles == nil {
+		return nil
+	}
+
+	bases := []string{em.Roles.Primary}
+	if integration := em.Roles.Integration(); integration != em.Roles.Primary {
+		bases = append(bases, integration)
+	}
+
+	reachable := make([]map[local.Hash]struct{}, len(bases))
+	mergedHeads := make([]map[local.Hash]struct{}, len(bases))
+	for i, base := range bases {
+		reachable[i] = em.BranchCommits(base)
+		mergedHeads[i] = em.mergedParents(reachable[i])
+	}
+
+	// Latest merged pull request of each head branch.
+	merged := make(map[string]*remote.PullRequest)
+	open := make(map[string]bool)
+	for _, pr := range em.PullRequests {
+		switch {
+		case pr.Merged:
+			if latest := merged[pr.HeadRefName]; latest == nil || pr.Number > latest.Number {
+				merged[pr.HeadRefName] = pr
+			}
+		case !pr.Closed:
+			open[pr.HeadRefName] = true
+		}
+	}
+
+	sorted := append([]local.Branch{}, em.Branches...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var branches []MergedBranch
+
+	for _, branch := range sorted {
+		name, head := branch.Name, branch.Head.Hash
+		// Branches with open pull requests are still being worked on, eg: a pull request without new commits yet.
+		if name == "HEAD" || em.Roles.LongLived(name) || open[name] {
+			continue
+		}
+
+		mb := MergedBranch{Branch: name, Head: head}
+		reachedBase := ""
+		for i, base := range bases {
+			if _, ok := reachable[i][head]; ok {
+				reachedBase = base
+
+				break
+			}
+		}
+
+		if pr := merged[name]; pr != nil && (reachedBase != "" || pr.HeadRefOid == head.HexString()) {
+			mb.PullRequest = pr
+			mb.Base = reachedBase
+			if mb.Base == "" {
+				mb.Base = pr.BaseRefName
+			}
+		} else {
+			// Without a pull request, only heads brought in by a merge commit are merged, heads on the first
+			// parent history of the base are even with it.
+			for i, base := range bases {
+				if _, ok := mergedHeads[i][head]; ok {
+					mb.Base = base
+
+					break
+				}
+			}
+		}
+
+		if mb.Base != "" {
+			branches = append(branches, mb)
+		}
+	}
+
+	return branches
+}
+
+// mergedParents finds the parents merged by the merge commits of the commits, the heads of the merged branches.
+func (em *EnrichedModel) mergedParents(commits map[local.Hash]struct{}) map[local.Hash]struct{} {
+	parents := make(map[local.Hash]struct{})
+	for i := range em.Commits {
+		commit := &em.Commits[i]
+		if _, ok := commits[commit.Hash]; !ok || len(commit.ParentHashes) < 2 {
+			continue
+		}
+
+		for _, parent := range commit.ParentHashes[1:] {
+			parents[parent] = struct{}{}
+		}
+	}
+
+	return parents
+}
@@ -0,0 +1,79 @@
+package enriched
+
+import (
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestMergedBranches(t *testing.T) {
+	// 1 ----- 3 - 4         main, fresh; old at 1 is behind without being merged
+	//  \     /   \
+	//   2 ---     5 - 6     feature and local merged by 3; squashed into 4 by #2; reused after #3 was merged
+	commits := []local.Commit{
+		commit(6, "Start the next change", 5),
+		commit(5, "Add signup", 3),
+		commit(4, "Add signup (#2)", 3),
+		commit(3, "Merge pull request #1 from Git-Gopher/feature", 1, 2),
+		commit(2, "Add login", 1),
+		commit(1, "Initial commit"),
+	}
+	branch := func(name string, head int) local.Branch {
+		return local.Branch{Name: name, Head: commits[len(commits)-head]}
+	}
+
+	em := NewEnrichedModel(local.GitModel{
+		Commits: commits,
+		Branches: []local.Branch{
+			branch("main", 4),
+			branch("HEAD", 4),
+			branch("fresh", 4),
+			branch("old", 1),
+			branch("feature", 2),
+			branch("local", 2),
+			branch("squashed", 5),
+			branch("reused", 6),
+			branch("open", 1),
+			branch("release/1.0", 1),
+		},
+	}, remote.RemoteModel{
+		PullRequests: []*remote.PullRequest{
+			{Number: 1, Merged: true, HeadRefName: "feature", BaseRefName: "main", HeadRefOid: hash(2).HexString()},
+			{Number: 2, Merged: true, HeadRefName: "squashed", BaseRefName: "main", HeadRefOid: hash(5).HexString()},
+			{Number: 3, Merged: true, HeadRefName: "reused", BaseRefName: "main", HeadRefOid: hash(5).HexString()},
+			{Number: 4, HeadRefName: "open", BaseRefName: "main"},
+		},
+	})
+
+	if got := em.MergedBranches(); got != nil {
+		t.Errorf("MergedBranches() without roles = %+v, want nil", got)
+	}
+
+	em.Roles = &BranchRoles{Primary: "main", Release: []string{"release/*"}}
+
+	want := []struct {
+		branch string
+		pr     int
+	}{
+		{"feature", 1},
+		{"local", 0},
+		{"squashed", 2},
+	}
+	got := em.MergedBranches()
+	if len(got) != len(want) {
+		t.Fatalf("MergedBranches() = %+v, want %+v", got, want)
+	}
+
+	for i, w := range want {
+		pr := 0
+		if got[i].PullRequest != nil {
+			pr = got[i].PullRequest.Number
+		}
+
+		if got[i].Branch != w.branch || got[i].Base != "main" || pr != w.pr {
+			t.Errorf("MergedBranches()[%d] = %s into %s by #%d, want %s into main by #%d",
+				i, got[i].Branch, got[i].Base, pr, w.branch, w.pr)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package enriched
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	log "github.com/sirupsen/logrus"
+)
+
+// templateDirs are the directories GitHub looks for pull request templates in.
+var templateDirs = []string{".github", "", "docs"}
+
+// PullRequestTemplates finds the pull request templates at the base commit of the pull request,
+// or at the head of the repository when the base commit is not known.
+func (em *EnrichedModel) PullRequestTemplates(pr *remote.PullRequest) []string {
+	if templates, ok := em.Templates[pr.BaseRefOid]; ok {
+		return templates
+	}
+
+	return em.Templates[""]
+}
+
+// readPullRequestTemplates reads the pull request templates at the base commit of every pull request,
+// keyed by the base commit. The templates at the head of the repository are keyed by "".
+func readPullRequestTemplates(repo *git.Repository, prs []*remote.PullRequest) map[string][]string {
+	if repo == nil {
+		return nil
+	}
+
+	templates := make(map[string][]string)
+
+	if ref, err := repo.Head(); err == nil {
+		if commit, cerr := repo.CommitObject(ref.Hash()); cerr == nil {
+			templates[""] = commitTemplates(commit)
+		}
+	}
+
+	for _, pr := range prs {
+		if pr == nil || pr.BaseRefOid == "" {
+			continue
+		}
+
+		if _, ok := templates[pr.BaseRefOid]; ok {
+			continue
+		}
+
+		commit, err := repo.CommitObject(plumbing.NewHash(pr.BaseRefOid))
+		if err != nil {
+			log.Warnf("base commit of pull request #%d not found: %v", pr.Number, err)
+
+			continue
+		}
+
+		templates[pr.BaseRefOid] = commitTemplates(commit)
+	}
+
+	return templates
+}
+
+// commitTemplates reads pull_request_template.md and the templates of the PULL_REQUEST_TEMPLATE directory,
+// of the first directory that has any.
+func commitTemplates(commit *object.Commit) []string {
+	root, err := commit.Tree()
+	if err != nil {
+		return nil
+	}
+
+	for _, dir := range templateDirs {
+		tree := root
+		if dir != "" {
+			if tree, err = root.Tree(dir); err != nil {
+				continue
+			}
+		}
+
+		var templates []string
+
+		for _, entry := range tree.Entries {
+			name := strings.ToLower(entry.Name)
+
+			switch {
+			case entry.Mode.IsFile() && isTemplateFile(name, "pull_request_template"):
+				if contents, ok := treeFile(tree, entry.Name); ok {
+					templates = append(templates, contents)
+				}
+			case !entry.Mode.IsFile() && name == "pull_request_template":
+				sub, serr := tree.Tree(entry.Name)
+				if serr != nil {
+					continue
+				}
+
+				for _, e := range sub.Entries {
+					if e.Mode.IsFile() && isTemplateFile(strings.ToLower(e.Name), "") {
+						if contents, ok := treeFile(sub, e.Name); ok {
+							templates = append(templates, contents)
+						}
+					}
+				}
+			}
+		}
+
+		if len(templates) != 0 {
+			sort.Strings(templates)
+
+			return templates
+		}
+	}
+
+	return nil
+}
+
+// isTemplateFile checks for a markdown or text file, with the base name when it is not empty.
+func isTemplateFile(name, base string) bool {
+	ext := path.Ext(name)
+	if ext != ".md" && ext != ".txt" {
+		return false
+	}
+
+	return base == "" || strings.TrimSuffix(name, ext) == base
+}
+
+func treeFile(tree *object.Tree, name string) (string, bool) {
+	file, err := tree.File(name)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return "", false
+	} else if err != nil {
+		log.Warnf("failed to find pull request template %s: %v", name, err)
+
+		return "", false
+	}
+
+	contents, err := file.Contents()
+	if err != nil {
+		log.Warnf("failed to read pull request template %s: %v", name, err)
+
+		return "", false
+	}
+
+	return contents, true
+}
@@ -0,0 +1,80 @@
+package enriched
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/remote"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+func TestReadPullRequestTemplates(t *testing.T) {
+	fs := memfs.New()
+	r, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := func(files map[string]string) string {
+		for name, content := range files {
+			if err = util.WriteFile(fs, name, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = w.Add(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		hash, cerr := w.Commit("Add templates", &git.CommitOptions{
+			Author: &object.Signature{Name: "Gopher", Email: "gopher@example.com", When: time.Now()},
+		})
+		if cerr != nil {
+			t.Fatal(cerr)
+		}
+
+		return hash.String()
+	}
+
+	base := commit(map[string]string{
+		"docs/pull_request_template.md": "## Docs\n",
+		"main.go":                       "package main\n",
+	})
+	head := commit(map[string]string{
+		".github/PULL_REQUEST_TEMPLATE/feature.md": "## Feature\n",
+		".github/PULL_REQUEST_TEMPLATE/bug.md":     "## Bug\n",
+		".github/PULL_REQUEST_TEMPLATE/README":     "Not a template\n",
+	})
+
+	templates := readPullRequestTemplates(r, []*remote.PullRequest{
+		{Number: 1, BaseRefOid: base},
+		{Number: 2, BaseRefOid: head},
+		{Number: 3, BaseRefOid: "0123456789012345678901234567890123456789"},
+	})
+	em := &EnrichedModel{Templates: templates}
+
+	tests := []struct {
+		pr   *remote.PullRequest
+		want []string
+	}{
+		{&remote.PullRequest{BaseRefOid: base}, []string{"## Docs\n"}},
+		{&remote.PullRequest{BaseRefOid: head}, []string{"## Bug\n", "## Feature\n"}},
+		// Unknown base commits use the head templates.
+		{&remote.PullRequest{BaseRefOid: "0123456789012345678901234567890123456789"}, []string{"## Bug\n", "## Feature\n"}},
+		{&remote.PullRequest{}, []string{"## Bug\n", "## Feature\n"}},
+	}
+	for _, tt := range tests {
+		if got := em.PullRequestTemplates(tt.pr); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("PullRequestTemplates(%s) = %q, want %q", tt.pr.BaseRefOid, got, tt.want)
+		}
+	}
+}
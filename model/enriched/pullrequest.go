@@ -0,0 +1,219 @@
+package enriched
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+// LinkReason is how a commit was found to be introduced by a pull request.
+type LinkReason int
+
+const (
+	// LinkPullRequestCommit is a commit listed on the pull request that still exists locally.
+	LinkPullRequestCommit LinkReason = iota
+	// LinkMergeCommit is the commit GitHub created on the target branch when merging.
+	LinkMergeCommit
+	// LinkMergedBranch is reachable from the second parent of the merge commit but not the first.
+	LinkMergedBranch
+	// LinkRebased is a rebased copy of a pull request commit on the target branch.
+	LinkRebased
+)
+
+// LinkReason string lookup.
+func (r LinkReason) String() string {
+	return [...]string{
+		"PullRequestCommit",
+		"MergeCommit",
+		"MergedBranch",
+		"Rebased",
+	}[r]
+}
+
+// PullRequestLink links a commit to a pull request that introduced it.
+type PullRequestLink struct {
+	PullRequest *remote.PullRequest
+	Reason      LinkReason
+}
+
+// LinkPullRequests maps every commit to the pull request(s) that introduced it.
+// Merge commits, squash merges and rebase merges are all followed using the
+// merge commit GitHub reports for the pull request.
+func (em *EnrichedModel) LinkPullRequests() {
+	em.CommitPullRequests = make(map[local.Hash][]PullRequestLink)
+	em.PullRequestCommits = make(map[int][]local.Hash)
+	em.PullRequestStrategies = make(map[int]MergeStrategy)
+
+	commits := make(map[string]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[em.Commits[i].Hash.HexString()] = &em.Commits[i]
+	}
+
+	for _, pr := range em.PullRequests {
+		if pr == nil {
+			continue
+		}
+
+		for _, c := range pr.Commits {
+			if commit, ok := commits[c.Oid]; ok {
+				em.link(commit.Hash, pr, LinkPullRequestCommit)
+			}
+		}
+
+		merge, ok := commits[pr.MergeCommit]
+		if !pr.Merged || !ok {
+			continue
+		}
+
+		em.link(merge.Hash, pr, LinkMergeCommit)
+
+		if len(merge.ParentHashes) > 1 {
+			// Merge commit: everything brought in by the other parents.
+			for _, h := range mergedBranchCommits(commits, merge) {
+				em.link(h, pr, LinkMergedBranch)
+			}
+			em.PullRequestStrategies[pr.Number] = MergeCommitStrategy
+
+			continue
+		}
+
+		// Squash or rebase merge: a rebase copies each pull request commit onto the
+		// target branch, ending with the merge commit. A squash is a single commit.
+		rebased := rebasedCommits(commits, merge, pr.Commits)
+		for _, h := range rebased {
+			em.link(h, pr, LinkRebased)
+		}
+		em.PullRequestStrategies[pr.Number] = classifyMergeStrategy(pr, merge, rebased)
+	}
+
+	// Keep a stable order for reports.
+	for number, hashes := range em.PullRequestCommits {
+		sort.Slice(hashes, func(i, j int) bool {
+			return hashes[i].HexString() < hashes[j].HexString()
+		})
+		em.PullRequestCommits[number] = hashes
+	}
+}
+
+// PullRequestsForCommit returns the pull requests that introduced a commit.
+func (em *EnrichedModel) PullRequestsForCommit(h local.Hash) []*remote.PullRequest {
+	links := em.CommitPullRequests[h]
+	prs := make([]*remote.PullRequest, 0, len(links))
+
+	for _, l := range links {
+		prs = append(prs, l.PullRequest)
+	}
+
+	return prs
+}
+
+// CommitsForPullRequest returns the commits introduced by a pull request.
+func (em *EnrichedModel) CommitsForPullRequest(pr *remote.PullRequest) []local.Hash {
+	if pr == nil {
+		return nil
+	}
+
+	return em.PullRequestCommits[pr.Number]
+}
+
+// link records a commit against a pull request once.
+func (em *EnrichedModel) link(h local.Hash, pr *remote.PullRequest, reason LinkReason) {
+	for _, l := range em.CommitPullRequests[h] {
+		if l.PullRequest.Number == pr.Number {
+			return
+		}
+	}
+
+	em.CommitPullRequests[h] = append(em.CommitPullRequests[h], PullRequestLink{PullRequest: pr, Reason: reason})
+	em.PullRequestCommits[pr.Number] = append(em.PullRequestCommits[pr.Number], h)
+}
+
+// mergedBranchCommits finds commits reachable from the merged parents of a merge
+// commit that are not reachable from its first parent.
+func mergedBranchCommits(commits map[string]*local.Commit, merge *local.Commit) []local.Hash {
+	base := ancestors(commits, merge.ParentHashes[:1])
+	merged := ancestors(commits, merge.ParentHashes[1:])
+
+	hashes := make([]local.Hash, 0)
+	for h := range merged {
+		if _, ok := base[h]; !ok {
+			hashes = append(hashes, h)
+		}
+	}
+
+	return hashes
+}
+
+// ancestors collects all commits reachable from the heads, inclusive.
+func ancestors(commits map[string]*local.Commit, heads []local.Hash) map[local.Hash]struct{} {
+	seen := make(map[local.Hash]struct{})
+	stack := append([]local.Hash{}, heads...)
+
+	for len(stack) != 0 {
+		n := len(stack) - 1
+		h := stack[n]
+		stack = stack[:n]
+
+		if _, ok := seen[h]; ok {
+			continue
+		}
+
+		commit, ok := commits[h.HexString()]
+		if !ok {
+			continue
+		}
+
+		seen[h] = struct{}{}
+		stack = append(stack, commit.ParentHashes...)
+	}
+
+	return seen
+}
+
+// rebasedCommits walks the first parents of a squash or rebase merge commit and
+// returns the commits whose headline matches the remaining pull request commits.
+func rebasedCommits(
+	commits map[string]*local.Commit,
+	merge *local.Commit,
+	prCommits []*remote.PullRequestCommit,
+) []local.Hash {
+	headlines := make(map[string]int)
+	for _, c := range prCommits {
+		headlines[c.MessageHeadline]++
+	}
+
+	hashes := make([]local.Hash, 0)
+	current := merge
+
+	// The merge commit itself is already linked, walk the copies below it.
+	for i := 0; i < len(prCommits); i++ {
+		headline := messageHeadline(current.Message)
+		if headlines[headline] == 0 {
+			break
+		}
+		headlines[headline]--
+
+		if current != merge {
+			hashes = append(hashes, current.Hash)
+		}
+
+		if len(current.ParentHashes) != 1 {
+			break
+		}
+
+		parent, ok := commits[current.ParentHashes[0].HexString()]
+		if !ok {
+			break
+		}
+		current = parent
+	}
+
+	return hashes
+}
+
+// messageHeadline is the first line of a commit message, as reported by GitHub.
+func messageHeadline(message string) string {
+	return strings.TrimSpace(strings.SplitN(message, "\n", 2)[0]) //nolint: gomnd
+}
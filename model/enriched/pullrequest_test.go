@@ -0,0 +1,160 @@
+package enriched
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func hash(b byte) local.Hash {
+	return local.Hash{b}
+}
+
+func commit(b byte, message string, parents ...byte) local.Commit {
+	c := local.Commit{Hash: hash(b), Message: message}
+	for _, p := range parents {
+		c.ParentHashes = append(c.ParentHashes, hash(p))
+	}
+
+	return c
+}
+
+func prCommit(b byte, headline string) *remote.PullRequestCommit {
+	return &remote.PullRequestCommit{Oid: hash(b).HexString(), MessageHeadline: headline}
+}
+
+func sortedHashes(hashes []local.Hash) []local.Hash {
+	sorted := append([]local.Hash{}, hashes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].HexString() < sorted[j].HexString()
+	})
+
+	return sorted
+}
+
+func TestLinkPullRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		commits  []local.Commit
+		pr       *remote.PullRequest
+		want     []local.Hash
+		reasons  map[local.Hash]LinkReason
+		strategy MergeStrategy
+	}{
+		{
+			"merge_commit",
+			[]local.Commit{
+				commit(1, "initial"),
+				commit(2, "main work", 1),
+				commit(3, "feature a", 1),
+				commit(4, "feature b", 3),
+				commit(5, "Merge pull request #1 from feature", 2, 4),
+			},
+			// Commit list is missing, the merged branch is followed instead.
+			&remote.PullRequest{Number: 1, Merged: true, MergeCommit: hash(5).HexString()},
+			[]local.Hash{hash(3), hash(4), hash(5)},
+			map[local.Hash]LinkReason{hash(3): LinkMergedBranch, hash(5): LinkMergeCommit},
+			MergeCommitStrategy,
+		},
+		{
+			"squash",
+			[]local.Commit{
+				commit(1, "initial"),
+				commit(2, "Add feature (#2)", 1),
+			},
+			&remote.PullRequest{
+				Number:      2,
+				Merged:      true,
+				MergeCommit: hash(2).HexString(),
+				Commits:     []*remote.PullRequestCommit{prCommit(10, "wip"), prCommit(11, "feature")},
+			},
+			[]local.Hash{hash(2)},
+			map[local.Hash]LinkReason{hash(2): LinkMergeCommit},
+			SquashStrategy,
+		},
+		{
+			"rebase",
+			[]local.Commit{
+				commit(1, "initial"),
+				commit(2, "feature a", 1),
+				commit(3, "feature b\n\nbody", 2),
+			},
+			&remote.PullRequest{
+				Number:      3,
+				Merged:      true,
+				MergeCommit: hash(3).HexString(),
+				Commits:     []*remote.PullRequestCommit{prCommit(10, "feature a"), prCommit(11, "feature b")},
+			},
+			[]local.Hash{hash(2), hash(3)},
+			map[local.Hash]LinkReason{hash(2): LinkRebased, hash(3): LinkMergeCommit},
+			RebaseStrategy,
+		},
+		{
+			"open",
+			[]local.Commit{
+				commit(1, "initial"),
+				commit(2, "feature", 1),
+			},
+			&remote.PullRequest{Number: 4, Commits: []*remote.PullRequestCommit{prCommit(2, "feature")}},
+			[]local.Hash{hash(2)},
+			map[local.Hash]LinkReason{hash(2): LinkPullRequestCommit},
+			UnknownStrategy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := NewEnrichedModel(
+				local.GitModel{Commits: tt.commits},
+				remote.RemoteModel{PullRequests: []*remote.PullRequest{tt.pr}},
+			)
+
+			if got := em.CommitsForPullRequest(tt.pr); !reflect.DeepEqual(got, sortedHashes(tt.want)) {
+				t.Errorf("CommitsForPullRequest() = %v, want %v", got, tt.want)
+			}
+
+			for h, reason := range tt.reasons {
+				links := em.CommitPullRequests[h]
+				if len(links) != 1 || links[0].Reason != reason {
+					t.Errorf("CommitPullRequests[%s] = %+v, want %v", h.HexString(), links, reason)
+				}
+			}
+
+			if got := em.MergeStrategy(tt.pr); got != tt.strategy {
+				t.Errorf("MergeStrategy() = %v, want %v", got, tt.strategy)
+			}
+
+			if prs := em.PullRequestsForCommit(hash(1)); len(prs) != 0 {
+				t.Errorf("PullRequestsForCommit() = %v, want none for base commit", prs)
+			}
+		})
+	}
+}
+
+func TestParseMergeStrategy(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    MergeStrategy
+		wantErr bool
+	}{
+		{"", UnknownStrategy, false},
+		{"any", UnknownStrategy, false},
+		{"Squash", SquashStrategy, false},
+		{"merge", MergeCommitStrategy, false},
+		{"rebase", RebaseStrategy, false},
+		{"fast-forward", UnknownStrategy, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseMergeStrategy(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseMergeStrategy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMergeStrategy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package enriched
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+// revertPattern matches the message git writes for reverts, eg: "This reverts commit 1a2b3c.".
+var revertPattern = regexp.MustCompile(`This reverts commit ([0-9a-f]{7,40})`)
+
+// RevertMethod is how a commit was found to revert another.
+type RevertMethod int
+
+const (
+	// RevertMessage is found by the "This reverts commit <sha>" message.
+	RevertMessage RevertMethod = iota
+	// RevertDiff is found by the diff being the inverse of an earlier commit.
+	RevertDiff
+)
+
+// RevertMethod string lookup.
+func (m RevertMethod) String() string {
+	return [...]string{
+		"message",
+		"diff",
+	}[m]
+}
+
+// Revert links a commit to the commit it reverts.
+type Revert struct {
+	Commit       local.Hash
+	Reverted     local.Hash
+	Method       RevertMethod
+	TimeToRevert time.Duration         // Between the reverted commit and the revert being committed.
+	PullRequests []*remote.PullRequest // Merged pull requests that introduced the reverted commit.
+}
+
+// RevertChain is a commit and its successive reverts, eg: a revert of a revert reapplies the commit.
+type RevertChain struct {
+	Original local.Hash
+	Reverts  []Revert // Each revert reverts the commit of the revert before it.
+}
+
+// Reapplied is set when the chain ends with the original change in effect.
+func (rc RevertChain) Reapplied() bool {
+	return len(rc.Reverts)%2 == 0
+}
+
+// FindReverts finds the commits that revert another commit, ordered by the time of the revert.
+// Reverts are found by their message, or otherwise by their diff being the inverse of an earlier commit.
+func (em *EnrichedModel) FindReverts() []Revert {
+	commits := make(map[string]*local.Commit, len(em.Commits))
+	patches := make(map[string][]*local.Commit)
+
+	for i := range em.Commits {
+		c := &em.Commits[i]
+		commits[c.Hash.HexString()] = c
+
+		if key, ok := patchKey(c, false); ok {
+			patches[key] = append(patches[key], c)
+		}
+	}
+
+	var reverts []Revert
+
+	for i := range em.Commits {
+		c := &em.Commits[i]
+
+		reverted := revertedByMessage(commits, c)
+		method := RevertMessage
+		if reverted == nil {
+			reverted = revertedByDiff(patches, c)
+			method = RevertDiff
+		}
+
+		if reverted == nil {
+			continue
+		}
+
+		var prs []*remote.PullRequest
+		for _, pr := range em.PullRequestsForCommit(reverted.Hash) {
+			if pr.Merged {
+				prs = append(prs, pr)
+			}
+		}
+
+		reverts = append(reverts, Revert{
+			Commit:       c.Hash,
+			Reverted:     reverted.Hash,
+			Method:       method,
+			TimeToRevert: c.Committer.When.Sub(reverted.Committer.When),
+			PullRequests: prs,
+		})
+	}
+
+	whens := make(map[local.Hash]time.Time, len(reverts))
+	for _, r := range reverts {
+		whens[r.Commit] = commits[r.Commit.HexString()].Committer.When
+	}
+	sort.SliceStable(reverts, func(i, j int) bool {
+		return whens[reverts[i].Commit].Before(whens[reverts[j].Commit])
+	})
+
+	return reverts
+}
+
+// RevertChains groups reverts by the original commit they started from.
+func RevertChains(reverts []Revert) []RevertChain {
+	byReverted := make(map[local.Hash]Revert, len(reverts))
+	isRevert := make(map[local.Hash]bool, len(reverts))
+
+	for _, r := range reverts {
+		byReverted[r.Reverted] = r
+		isRevert[r.Commit] = true
+	}
+
+	var chains []RevertChain
+
+	for _, r := range reverts {
+		// Chains start from commits that are not reverts themselves.
+		if isRevert[r.Reverted] {
+			continue
+		}
+
+		chain := RevertChain{Original: r.Reverted}
+		seen := map[local.Hash]bool{r.Reverted: true}
+		for next, ok := byReverted[r.Reverted]; ok && !seen[next.Commit]; next, ok = byReverted[next.Commit] {
+			seen[next.Commit] = true
+			chain.Reverts = append(chain.Reverts, next)
+		}
+
+		chains = append(chains, chain)
+	}
+
+	return chains
+}
+
+// revertedByMessage finds the commit named by a revert message, abbreviated hashes are matched by prefix.
+func revertedByMessage(commits map[string]*local.Commit, c *local.Commit) *local.Commit {
+	match := revertPattern.FindStringSubmatch(c.Message)
+	if match == nil {
+		return nil
+	}
+
+	if reverted, ok := commits[match[1]]; ok {
+		return reverted
+	}
+
+	var found *local.Commit
+	for hash, commit := range commits {
+		if strings.HasPrefix(hash, match[1]) {
+			// Ambiguous abbreviation.
+			if found != nil {
+				return nil
+			}
+			found = commit
+		}
+	}
+
+	return found
+}
+
+// revertedByDiff finds the latest earlier commit whose diff is the inverse of the diff of the commit.
+func revertedByDiff(patches map[string][]*local.Commit, c *local.Commit) *local.Commit {
+	key, ok := patchKey(c, true)
+	if !ok {
+		return nil
+	}
+
+	var found *local.Commit
+	for _, candidate := range patches[key] {
+		if !candidate.Committer.When.Before(c.Committer.When) {
+			continue
+		}
+
+		if found == nil || candidate.Committer.When.After(found.Committer.When) {
+			found = candidate
+		}
+	}
+
+	return found
+}
+
+// patchKey identifies the changes of a commit, inverted swaps the additions and deletions.
+// Merge commits and commits without text changes have no key.
+func patchKey(c *local.Commit, inverted bool) (string, bool) {
+	if len(c.ParentHashes) > 1 || len(c.DiffToParents) == 0 {
+		return "", false
+	}
+
+	diffs := make([]string, 0, len(c.DiffToParents))
+	for _, d := range c.DiffToParents {
+		if d.IsBinary || (d.Addition == "" && d.Deletion == "") {
+			return "", false
+		}
+
+		added, deleted := d.Addition, d.Deletion
+		if inverted {
+			added, deleted = deleted, added
+		}
+		diffs = append(diffs, d.Name+"\x00+"+added+"\x00-"+deleted)
+	}
+	sort.Strings(diffs)
+
+	return strings.Join(diffs, "\x00"), true
+}
@@ -0,0 +1,73 @@
+package enriched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestFindReverts(t *testing.T) {
+	when := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	change := func(c local.Commit, hours int, diff local.Diff) local.Commit {
+		c.Committer.When = when.Add(time.Duration(hours) * time.Hour)
+		c.DiffToParents = []local.Diff{diff}
+
+		return c
+	}
+
+	login := local.Diff{Name: "login.go", Addition: "func Login() {}\n"}
+	unlogin := local.Diff{Name: "login.go", Deletion: "func Login() {}\n"}
+	signup := local.Diff{Name: "signup.go", Addition: "func Signup() {}\n"}
+	unsignup := local.Diff{Name: "signup.go", Deletion: "func Signup() {}\n"}
+
+	em := NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			change(commit(5, "Remove signup\n\nThis reverts commit "+hash(4).HexString()[:7]+".", 4), 6, unsignup),
+			change(commit(4, "Add signup", 3), 4, signup),
+			change(commit(3, "Reapply login", 2), 3, login),
+			change(commit(2, "Revert \"Add login\"\n\nThis reverts commit "+hash(1).HexString()+".", 1), 1, unlogin),
+			change(commit(1, "Add login (#7)"), 0, login),
+		},
+	}, remote.RemoteModel{
+		PullRequests: []*remote.PullRequest{{Number: 7, Merged: true, MergeCommit: hash(1).HexString()}},
+	})
+
+	reverts := em.FindReverts()
+
+	want := []struct {
+		commit, reverted byte
+		method           RevertMethod
+		hours            int
+		prs              int
+	}{
+		{2, 1, RevertMessage, 1, 1},
+		{3, 2, RevertDiff, 2, 0},
+		{5, 4, RevertMessage, 2, 0},
+	}
+	if len(reverts) != len(want) {
+		t.Fatalf("FindReverts() = %+v, want %d reverts", reverts, len(want))
+	}
+
+	for i, w := range want {
+		r := reverts[i]
+		if r.Commit != hash(w.commit) || r.Reverted != hash(w.reverted) || r.Method != w.method ||
+			r.TimeToRevert != time.Duration(w.hours)*time.Hour || len(r.PullRequests) != w.prs {
+			t.Errorf("FindReverts()[%d] = %+v, want %+v", i, r, w)
+		}
+	}
+
+	chains := RevertChains(reverts)
+	if len(chains) != 2 {
+		t.Fatalf("RevertChains() = %+v, want 2 chains", chains)
+	}
+
+	if chains[0].Original != hash(1) || len(chains[0].Reverts) != 2 || !chains[0].Reapplied() {
+		t.Errorf("RevertChains()[0] = %+v, want reapplied chain from 1", chains[0])
+	}
+
+	if chains[1].Original != hash(4) || len(chains[1].Reverts) != 1 || chains[1].Reapplied() {
+		t.Errorf("RevertChains()[1] = %+v, want reverted chain from 4", chains[1])
+	}
+}
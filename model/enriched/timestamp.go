@@ -0,0 +1,108 @@
+package enriched
+
+import (
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+)
+
+// TimestampAnomalyKind is the way a commit timestamp can not be trusted.
+type TimestampAnomalyKind int
+
+const (
+	// FutureTimestamp is an author or committer date after the analysis.
+	FutureTimestamp TimestampAnomalyKind = iota
+	// PredatesRepository is an author date before the first commit of the repository, eg: a backdated commit.
+	PredatesRepository
+	// OutOfOrderTimestamp is a committer date before the committer date of a parent.
+	OutOfOrderTimestamp
+	// BackdatedPastCutoff is an author date before the cutoff of a commit committed after it, eg: a commit made
+	// after the deadline with "git commit --date", which only sets the author date.
+	BackdatedPastCutoff
+	// DateGap is a committer date long after the author date, the commit was rebased, amended or backdated.
+	DateGap
+)
+
+// TimestampAnomalyKind string lookup.
+func (k TimestampAnomalyKind) String() string {
+	return [...]string{
+		"future",
+		"predates-repository",
+		"out-of-order",
+		"backdated",
+		"date-gap",
+	}[k]
+}
+
+// TimestampAnomaly is a commit with a timestamp that can not be trusted.
+type TimestampAnomaly struct {
+	Commit    local.Hash
+	Kind      TimestampAnomalyKind
+	Reference time.Time // Time the timestamp was compared with, eg: the committer date of the parent.
+}
+
+// RepositoryStart is the earliest author or committer date of the root commits, zero without commits.
+func (em *EnrichedModel) RepositoryStart() time.Time {
+	var start time.Time
+	for _, c := range em.Commits {
+		if len(c.ParentHashes) > 0 {
+			continue
+		}
+
+		for _, when := range []time.Time{c.Author.When, c.Committer.When} {
+			if start.IsZero() || when.Before(start) {
+				start = when
+			}
+		}
+	}
+
+	return start
+}
+
+// TimestampAnomalies finds the commits with timestamps that can not be trusted. Timestamps within skew of the
+// time they are compared with are allowed for clock differences between machines. Commits authored before the
+// cutoff but committed after it are reported, a zero cutoff disables the check. Author and committer dates
+// further than gap apart are reported, zero disables the check. Each commit is reported once, by the first
+// anomaly in the order of the kinds.
+func (em *EnrichedModel) TimestampAnomalies(now, cutoff time.Time, skew, gap time.Duration) []TimestampAnomaly {
+	commits := make(map[string]*local.Commit, len(em.Commits))
+	for i := range em.Commits {
+		commits[em.Commits[i].Hash.HexString()] = &em.Commits[i]
+	}
+
+	start := em.RepositoryStart()
+
+	var anomalies []TimestampAnomaly
+
+	for i := range em.Commits {
+		c := &em.Commits[i]
+		anomaly := TimestampAnomaly{Commit: c.Hash}
+
+		// Latest committer date of the parents.
+		var parent time.Time
+		for _, h := range c.ParentHashes {
+			if p, ok := commits[h.HexString()]; ok && p.Committer.When.After(parent) {
+				parent = p.Committer.When
+			}
+		}
+
+		switch {
+		case c.Author.When.After(now.Add(skew)) || c.Committer.When.After(now.Add(skew)):
+			anomaly.Kind, anomaly.Reference = FutureTimestamp, now
+		case !start.IsZero() && c.Author.When.Before(start.Add(-skew)):
+			anomaly.Kind, anomaly.Reference = PredatesRepository, start
+		case !parent.IsZero() && c.Committer.When.Before(parent.Add(-skew)):
+			anomaly.Kind, anomaly.Reference = OutOfOrderTimestamp, parent
+		case !cutoff.IsZero() && c.Author.When.Before(cutoff) && c.Committer.When.After(cutoff.Add(skew)):
+			anomaly.Kind, anomaly.Reference = BackdatedPastCutoff, cutoff
+		case gap > 0 && c.Committer.When.Sub(c.Author.When) > gap:
+			anomaly.Kind, anomaly.Reference = DateGap, c.Committer.When
+		default:
+			continue
+		}
+
+		anomalies = append(anomalies, anomaly)
+	}
+
+	return anomalies
+}
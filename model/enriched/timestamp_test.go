@@ -0,0 +1,65 @@
+package enriched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/model/local"
+	"github.com/Git-Gopher/go-gopher/model/remote"
+)
+
+func TestTimestampAnomalies(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	dated := func(b byte, author, committer time.Time, parents ...byte) local.Commit {
+		c := commit(b, "", parents...)
+		c.Author.When, c.Committer.When = author, committer
+
+		return c
+	}
+	day := func(d int) time.Time {
+		return time.Date(2022, 5, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	em := NewEnrichedModel(local.GitModel{
+		Commits: []local.Commit{
+			dated(7, day(20), day(20).Add(-30*time.Minute), 6),  // Within the clock skew of the parent.
+			dated(6, day(2), day(20), 4),                        // Rebased, or backdated.
+			dated(5, day(10), day(10), 3),                       // Committed before its parent.
+			dated(4, now.Add(48*time.Hour), day(5), 3),          // Authored in the future.
+			dated(3, day(1).Add(-365*24*time.Hour), day(12), 2), // Backdated before the repository.
+			dated(2, day(1), day(11), 1),
+			dated(1, day(1), day(1)),
+		},
+	}, remote.RemoteModel{})
+
+	if start := em.RepositoryStart(); !start.Equal(day(1)) {
+		t.Errorf("RepositoryStart() = %v, want %v", start, day(1))
+	}
+
+	want := []TimestampAnomaly{
+		{Commit: hash(6), Kind: DateGap, Reference: day(20)},
+		{Commit: hash(5), Kind: OutOfOrderTimestamp, Reference: day(12)},
+		{Commit: hash(4), Kind: FutureTimestamp, Reference: now},
+		{Commit: hash(3), Kind: PredatesRepository, Reference: day(1)},
+	}
+	got := em.TimestampAnomalies(now, time.Time{}, time.Hour, 14*24*time.Hour)
+	if len(got) != len(want) {
+		t.Fatalf("TimestampAnomalies() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i].Commit != want[i].Commit || got[i].Kind != want[i].Kind || !got[i].Reference.Equal(want[i].Reference) {
+			t.Errorf("TimestampAnomalies()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got := em.TimestampAnomalies(now, time.Time{}, time.Hour, 0); len(got) != 3 {
+		t.Errorf("TimestampAnomalies() without gap = %d anomalies, want 3", len(got))
+	}
+
+	// Commit 6 was authored before the cutoff but committed after it, commit 2 was committed within the skew.
+	got = em.TimestampAnomalies(now, day(11).Add(-30*time.Minute), time.Hour, 0)
+	if len(got) != 4 || got[0].Commit != hash(6) || got[0].Kind != BackdatedPastCutoff {
+		t.Errorf("TimestampAnomalies() with cutoff = %+v, want commit 6 backdated first", got)
+	}
+}
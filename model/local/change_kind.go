@@ -0,0 +1,226 @@
+package local
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ChangeKind classifies what a diff or commit changes, formatting changes do not change the content.
+type ChangeKind int
+
+const (
+	// ContentChange changes the content, eg: code was added or removed.
+	ContentChange ChangeKind = iota
+	// ModeChange only changes file modes, eg: a script was made executable.
+	ModeChange
+	// LineEndingChange only converts line endings between CRLF and LF.
+	LineEndingChange
+	// WhitespaceChange only changes whitespace within lines or adds and removes blank lines, eg: reindentation.
+	WhitespaceChange
+	// ReformatChange only changes whitespace including line breaks, eg: wrapping or joining lines by a formatter.
+	ReformatChange
+)
+
+// ChangeKind string lookup.
+func (k ChangeKind) String() string {
+	return [...]string{
+		"content",
+		"mode",
+		"line-ending",
+		"whitespace",
+		"reformat",
+	}[k]
+}
+
+// IsFormatting checks if the change only changes formatting.
+func (k ChangeKind) IsFormatting() bool {
+	return k != ContentChange
+}
+
+// changeKindRank orders the kinds from the least to the most change, a commit is the most change of its diffs.
+var changeKindRank = map[ChangeKind]int{
+	ModeChange:       0,
+	LineEndingChange: 1,
+	WhitespaceChange: 2,
+	ReformatChange:   3,
+	ContentChange:    4,
+}
+
+// ChangeKind classifies the diff by the hunk with the most change, the deleted and added lines of each hunk are
+// normalised until they are equal.
+func (d *Diff) ChangeKind() ChangeKind {
+	if d.IsBinary || d.IsLFSPointer {
+		return ContentChange
+	}
+
+	if d.Addition == "" && d.Deletion == "" {
+		if d.ModeChanged {
+			return ModeChange
+		}
+
+		// Eg: an empty file was added or a file was renamed.
+		return ContentChange
+	}
+
+	kind := ModeChange
+	for _, h := range d.hunks() {
+		if k := h.changeKind(); changeKindRank[k] > changeKindRank[kind] {
+			kind = k
+		}
+	}
+
+	return kind
+}
+
+// ChangeKind classifies the commit by the diff with the most change, commits without diffs change content.
+func (c *Commit) ChangeKind() ChangeKind {
+	if len(c.DiffToParents) == 0 {
+		return ContentChange
+	}
+
+	kind := ModeChange
+	for i := range c.DiffToParents {
+		if k := c.DiffToParents[i].ChangeKind(); changeKindRank[k] > changeKindRank[kind] {
+			kind = k
+		}
+	}
+
+	return kind
+}
+
+// diffHunk is a run of deleted and added lines between unchanged lines.
+type diffHunk struct {
+	deletion []string
+	addition []string
+}
+
+// hunks splits the deletion and addition into hunks using the diff points, so lines moved elsewhere in the file are
+// not compared to themselves. Diffs without points are a single hunk.
+func (d *Diff) hunks() []diffHunk {
+	deleted := strings.Split(d.Deletion, "\n")
+	added := strings.Split(d.Addition, "\n")
+
+	var hunks []diffHunk
+	var hunk diffHunk
+	// take removes the lines of a chunk, and the empty line separating chunks.
+	take := func(lines *[]string, n int64) []string {
+		if int(n) > len(*lines) {
+			n = int64(len(*lines))
+		}
+		chunk := (*lines)[:n]
+		*lines = (*lines)[n:]
+		if len(*lines) != 0 && (*lines)[0] == "" {
+			*lines = (*lines)[1:]
+		}
+
+		return chunk
+	}
+
+	for _, p := range d.Points {
+		if p.LinesDeleted == 0 && p.LinesAdded == 0 {
+			if len(hunk.deletion) != 0 || len(hunk.addition) != 0 {
+				hunks = append(hunks, hunk)
+				hunk = diffHunk{}
+			}
+
+			continue
+		}
+
+		hunk.deletion = append(hunk.deletion, take(&deleted, p.LinesDeleted)...)
+		hunk.addition = append(hunk.addition, take(&added, p.LinesAdded)...)
+	}
+
+	if len(hunk.deletion) != 0 || len(hunk.addition) != 0 {
+		hunks = append(hunks, hunk)
+	}
+
+	if len(hunks) == 0 {
+		return []diffHunk{{deletion: deleted, addition: added}}
+	}
+
+	return hunks
+}
+
+// changeKind classifies the hunk, line endings only change when the lines only differ by CRLF and LF.
+func (h diffHunk) changeKind() ChangeKind {
+	deletion := strings.Join(h.deletion, "\n")
+	addition := strings.Join(h.addition, "\n")
+
+	if deletion == addition {
+		// Eg: lines moved within the hunk.
+		return ContentChange
+	}
+
+	deletion = strings.ReplaceAll(deletion, "\r", "")
+	addition = strings.ReplaceAll(addition, "\r", "")
+
+	switch {
+	case deletion == addition:
+		return LineEndingChange
+	case normaliseLines(deletion) == normaliseLines(addition):
+		return WhitespaceChange
+	case equalTokens(tokens(deletion), tokens(addition)):
+		return ReformatChange
+	default:
+		return ContentChange
+	}
+}
+
+// normaliseLines collapses the whitespace within lines and drops blank lines.
+func normaliseLines(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if fields := strings.Fields(line); len(fields) != 0 {
+			lines = append(lines, strings.Join(fields, " "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// tokens splits the whitespace separated fields into words and punctuation, so whitespace may be added or removed
+// next to punctuation, eg: wrapping arguments, but not within words, eg: "return x" and "returnx" differ.
+func tokens(s string) []string {
+	var tokens []string
+	for _, field := range strings.Fields(s) {
+		start := 0
+		for i, r := range field {
+			if isWordRune(r) {
+				continue
+			}
+
+			if start < i {
+				tokens = append(tokens, field[start:i])
+			}
+			tokens = append(tokens, string(r))
+			start = i + utf8.RuneLen(r)
+		}
+
+		if start < len(field) {
+			tokens = append(tokens, field[start:])
+		}
+	}
+
+	return tokens
+}
+
+// isWordRune checks if the rune is part of an identifier or number.
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// equalTokens checks if the token sequences are equal.
+func equalTokens(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,79 @@
+package local
+
+import "testing"
+
+func TestDiffChangeKind(t *testing.T) {
+	tests := []struct {
+		name string
+		diff Diff
+		want ChangeKind
+	}{
+		{"content", Diff{Deletion: "x := 1\n", Addition: "x := 2\n"}, ContentChange},
+		{"added file", Diff{Addition: "package main\n"}, ContentChange},
+		{"empty file", Diff{}, ContentChange},
+		{"binary", Diff{IsBinary: true, ModeChanged: true}, ContentChange},
+		{"mode", Diff{ModeChanged: true}, ModeChange},
+		{"crlf to lf", Diff{Deletion: "a\r\nb\r\n", Addition: "a\nb\n"}, LineEndingChange},
+		{"lf to crlf", Diff{Deletion: "a\nb\n", Addition: "a\r\nb\r\n", ModeChanged: true}, LineEndingChange},
+		{"indentation", Diff{Deletion: "    return x\n", Addition: "\treturn x\n"}, WhitespaceChange},
+		{"trailing and blank", Diff{Deletion: "a  \nb\n", Addition: "a\n\nb \n"}, WhitespaceChange},
+		{"crlf and indentation", Diff{Deletion: "  a\r\n", Addition: "\ta\n"}, WhitespaceChange},
+		{"wrapped", Diff{Deletion: "f(a, b)\n", Addition: "f(\n\ta,\n\tb)\n"}, ReformatChange},
+		{"joined words", Diff{Deletion: "hello world\n", Addition: "helloworld\n"}, ContentChange},
+		{"joined tokens", Diff{Deletion: "return x\n", Addition: "returnx\n"}, ContentChange},
+		{"moved block", Diff{
+			// x, a, b, y to x, y, a, b.
+			Deletion: "a\nb\n\n",
+			Addition: "a\nb\n\n",
+			Points: []DiffPoint{
+				{OldPosition: 1, NewPosition: 1},
+				{OldPosition: 3, NewPosition: 1, LinesDeleted: 2},
+				{OldPosition: 4, NewPosition: 2},
+				{OldPosition: 6, NewPosition: 4, LinesAdded: 2},
+			},
+		}, ContentChange},
+		{"moved block without points", Diff{Deletion: "a\nb\n", Addition: "a\nb\n"}, ContentChange},
+		{"crlf hunks", Diff{
+			Deletion: "a\r\n\nc\r\n\n",
+			Addition: "a\n\nc\n\n",
+			Points: []DiffPoint{
+				{LinesDeleted: 1}, {LinesAdded: 1}, {}, {LinesDeleted: 1}, {LinesAdded: 1},
+			},
+		}, LineEndingChange},
+		{"reformat and content", Diff{Deletion: "f(a, b)\n", Addition: "f(\n\ta,\n\tc)\n"}, ContentChange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.diff.ChangeKind(); got != tt.want {
+				t.Errorf("ChangeKind() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommitChangeKind(t *testing.T) {
+	mode := Diff{Name: "run.sh", ModeChanged: true}
+	crlf := Diff{Name: "a.txt", Deletion: "a\r\n", Addition: "a\n"}
+	indent := Diff{Name: "main.go", Deletion: "  a\n", Addition: "\ta\n"}
+	content := Diff{Name: "main.go", Addition: "b\n"}
+
+	tests := []struct {
+		name  string
+		diffs []Diff
+		want  ChangeKind
+	}{
+		{"no diffs", nil, ContentChange},
+		{"mode", []Diff{mode}, ModeChange},
+		{"mode and line endings", []Diff{mode, crlf}, LineEndingChange},
+		{"line endings and whitespace", []Diff{crlf, indent}, WhitespaceChange},
+		{"formatting and content", []Diff{mode, crlf, content}, ContentChange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Commit{DiffToParents: tt.diffs}
+			if got := c.ChangeKind(); got != tt.want {
+				t.Errorf("ChangeKind() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -37,11 +37,14 @@ func FetchDiffs(patch *object.Patch) ([]Diff, error) {
 		default:
 			name = from.Path()
 		}
+		modeChanged := from != nil && to != nil && from.Mode() != to.Mode()
+
 		// Patch is binary.
 		if len(chunks) == 0 {
 			diffs = append(diffs, Diff{
-				Name:     name,
-				IsBinary: fp.IsBinary(),
+				Name:        name,
+				IsBinary:    fp.IsBinary(),
+				ModeChanged: modeChanged,
 			})
 
 			continue
@@ -63,7 +66,8 @@ func FetchDiffs(patch *object.Patch) ([]Diff, error) {
 			Deletion: deleted,
 			Equal:    equal,
 
-			Points: diffPoints,
+			Points:      diffPoints,
+			ModeChanged: modeChanged,
 		})
 	}
 
@@ -167,3 +171,50 @@ func splitLines(s string) ([]string, error) {
 
 	return lines, nil
 }
+
+// DiffLine is a line of a diff.
+type DiffLine struct {
+	Number  int // Line number in the new file, 0 when the diff has no points.
+	Content string
+}
+
+// AddedLines splits the addition into lines, numbered using the diff points.
+func (d *Diff) AddedLines() []DiffLine {
+	lines := strings.Split(d.Addition, "\n")
+	added := make([]DiffLine, 0, len(lines))
+
+	var points []DiffPoint
+	for _, p := range d.Points {
+		if p.LinesAdded > 0 {
+			points = append(points, p)
+		}
+	}
+
+	// Numbers are unknown, eg: diffs built without chunks.
+	if len(points) == 0 {
+		for _, l := range lines {
+			if l != "" {
+				added = append(added, DiffLine{Content: l})
+			}
+		}
+
+		return added
+	}
+
+	i := 0
+	for _, p := range points {
+		// The new position is the last line of the added chunk.
+		start := int(p.NewPosition-p.LinesAdded) + 1
+		for n := 0; n < int(p.LinesAdded) && i < len(lines); n++ {
+			added = append(added, DiffLine{Number: start + n, Content: lines[i]})
+			i++
+		}
+
+		// Chunks in the addition are separated by an empty line.
+		if i < len(lines) && lines[i] == "" {
+			i++
+		}
+	}
+
+	return added
+}
@@ -0,0 +1,49 @@
+package local
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+)
+
+type chunk struct {
+	content string
+	op      diff.Operation
+}
+
+func (c chunk) Content() string      { return c.content }
+func (c chunk) Type() diff.Operation { return c.op }
+
+func TestAddedLines(t *testing.T) {
+	chunks := []diff.Chunk{
+		chunk{"1\n2\n", diff.Equal},
+		chunk{"3\n", diff.Delete},
+		chunk{"three\n", diff.Add},
+		chunk{"4\n5\n6\n7\n8\n", diff.Equal},
+		chunk{"nine\nten\n", diff.Add},
+	}
+
+	_, added, _, err := Defragment(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points, err := DefragmentToDiffPoint(chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := Diff{Name: "main.go", Addition: added, Points: points}
+	want := []DiffLine{{3, "three"}, {9, "nine"}, {10, "ten"}}
+	if got := d.AddedLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AddedLines() = %+v, want %+v", got, want)
+	}
+
+	// Without points the lines are not numbered.
+	d = Diff{Addition: added}
+	want = []DiffLine{{0, "three"}, {0, "nine"}, {0, "ten"}}
+	if got := d.AddedLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AddedLines() = %+v, want %+v", got, want)
+	}
+}
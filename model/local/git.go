@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/conventional"
 	"github.com/bluekeyes/go-gitdiff/gitdiff"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -29,6 +30,19 @@ var (
 
 type Hash [20]byte
 
+// NewHash creates a hash from its hex representation.
+func NewHash(s string) (Hash, error) {
+	var h Hash
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return h, fmt.Errorf("unable to decode hash %s: %w", s, err)
+	}
+	copy(h[:], b)
+
+	return h, nil
+}
+
 func (h Hash) ToByte() []byte {
 	return h[:]
 }
@@ -70,6 +84,13 @@ type Diff struct {
 	Deletion string
 
 	Points []DiffPoint `json:"-"`
+
+	// Size of the file blob after the commit in bytes, 0 when the file is deleted.
+	Size int64
+	// IsLFSPointer is set when the file is a Git LFS pointer instead of the file content.
+	IsLFSPointer bool
+	// ModeChanged is set when the file mode changed, eg: the file was made executable.
+	ModeChanged bool
 }
 
 type DiffPoint struct {
@@ -99,6 +120,14 @@ type Commit struct {
 	DiffToParents []Diff `json:"-"`
 	// PatchID is the hash of the patch. If empty means more than one parent (not cherry-picked)
 	PatchID *string `json:"-"`
+	// MergeChanges are the files a merge commit changed compared to every parent. They are costly so are only
+	// fetched on demand, see FetchMergeChanges, and are stored in exported models.
+	MergeChanges []MergeChange `json:"-"`
+}
+
+// Conventional parses the commit message with the Conventional Commits grammar.
+func (c *Commit) Conventional() (*conventional.Message, error) {
+	return conventional.Parse(c.Message) //nolint: wrapcheck
 }
 
 type Committer struct {
@@ -198,6 +227,9 @@ func NewCommit(r *git.Repository, c *object.Commit) (*Commit, error) {
 	}
 
 	diffs = append(diffs, diff...)
+	for i := range diffs {
+		setBlobInfo(commitTree, &diffs[i])
+	}
 
 	return &Commit{
 		Hash:          Hash(c.Hash),
@@ -211,15 +243,8 @@ func NewCommit(r *git.Repository, c *object.Commit) (*Commit, error) {
 	}, nil
 }
 
-// TODO: Might be useful to add some of these to the Branch struct.
-//
-//	type MockBranchModel struct {
-//		Ref           string
-//		Remote        string
-//		Hash          string
-//		CommitsBehind int       // Number of commits behind the primary branch
-//		LastChange    time.Time // Time of the head commit of the current branch
-//	}.
+// Branch is a remote branch. The commits behind and ahead of the branch it merges into
+// depend on the branch roles, see enriched.Divergences.
 type Branch struct {
 	// Hash of head commit
 	Head Commit
@@ -271,6 +296,10 @@ type GitModel struct {
 	MainGraph    *BranchGraph
 	BranchMatrix []*BranchMatrix
 	Tags         []*Tag
+	// Patterns of the head .gitattributes tracked by Git LFS.
+	LFSPatterns []string
+	// Patterns of the head .gitignore files.
+	IgnorePatterns []IgnorePattern
 
 	// Not all functionality has been ported from go-git.
 	Repository *git.Repository
@@ -318,6 +347,18 @@ func NewGitModel(repo *git.Repository) (*GitModel, error) {
 	}
 	gitModel.MainGraph = FetchBranchGraph(refCommit)
 
+	// LFS patterns
+	if attributes, ferr := refCommit.File(".gitattributes"); ferr == nil {
+		if contents, cerr := attributes.Contents(); cerr == nil {
+			gitModel.LFSPatterns = ParseLFSPatterns(contents)
+		}
+	}
+
+	// Ignore patterns
+	if tree, terr := refCommit.Tree(); terr == nil {
+		gitModel.IgnorePatterns = ignorePatterns(tree)
+	}
+
 	// Branches
 	branches := []plumbing.Hash{}
 	rIter, err := repo.References()
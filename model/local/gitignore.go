@@ -0,0 +1,96 @@
+package local
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// IgnorePattern is a pattern of a .gitignore file, relative to the directory of the file.
+type IgnorePattern struct {
+	Dir     string // Directory of the .gitignore file, empty for the root.
+	Pattern string
+}
+
+// ParseIgnorePatterns finds the patterns of a .gitignore file in the directory, skipping comments and blank lines.
+func ParseIgnorePatterns(dir, contents string) []IgnorePattern {
+	var patterns []IgnorePattern
+
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		patterns = append(patterns, IgnorePattern{Dir: dir, Pattern: line})
+	}
+
+	return patterns
+}
+
+// IgnoreMatcher matches files against .gitignore patterns.
+type IgnoreMatcher struct {
+	matcher gitignore.Matcher
+}
+
+// NewIgnoreMatcher creates a matcher of the patterns, ordered from the root to the deepest .gitignore file so
+// patterns of nested files take priority.
+func NewIgnoreMatcher(patterns []IgnorePattern) *IgnoreMatcher {
+	sorted := append([]IgnorePattern{}, patterns...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return depth(sorted[i].Dir) < depth(sorted[j].Dir)
+	})
+
+	ps := make([]gitignore.Pattern, len(sorted))
+	for i, p := range sorted {
+		var domain []string
+		if p.Dir != "" {
+			domain = strings.Split(p.Dir, "/")
+		}
+		ps[i] = gitignore.ParsePattern(p.Pattern, domain)
+	}
+
+	return &IgnoreMatcher{matcher: gitignore.NewMatcher(ps)}
+}
+
+// IsIgnored checks if the file would be ignored by git when it was not committed.
+func (m *IgnoreMatcher) IsIgnored(name string) bool {
+	return m.matcher.Match(strings.Split(name, "/"), false)
+}
+
+// ignorePatterns reads the patterns of all .gitignore files in the tree.
+func ignorePatterns(tree *object.Tree) []IgnorePattern {
+	var patterns []IgnorePattern
+
+	_ = tree.Files().ForEach(func(f *object.File) error {
+		if path.Base(f.Name) != ".gitignore" {
+			return nil
+		}
+
+		contents, err := f.Contents()
+		if err != nil {
+			return nil //nolint: nilerr
+		}
+
+		dir := path.Dir(f.Name)
+		if dir == "." {
+			dir = ""
+		}
+		patterns = append(patterns, ParseIgnorePatterns(dir, contents)...)
+
+		return nil
+	})
+
+	return patterns
+}
+
+func depth(dir string) int {
+	if dir == "" {
+		return 0
+	}
+
+	return strings.Count(dir, "/") + 1
+}
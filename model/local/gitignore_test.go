@@ -0,0 +1,102 @@
+package local
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+func TestParseIgnorePatterns(t *testing.T) {
+	contents := "# Build output\r\nbin/\r\n\r\n*.log\r\n!keep.log\r\n"
+
+	want := []IgnorePattern{{"web", "bin/"}, {"web", "*.log"}, {"web", "!keep.log"}}
+	if got := ParseIgnorePatterns("web", contents); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIgnorePatterns() = %v, want %v", got, want)
+	}
+}
+
+func TestIgnoreMatcher(t *testing.T) {
+	// Nested patterns are given first to check they still take priority.
+	patterns := append(
+		ParseIgnorePatterns("web", "!debug.log\ndist/\n"),
+		ParseIgnorePatterns("", "*.log\n/build\nnode_modules/\n")...,
+	)
+	m := NewIgnoreMatcher(patterns)
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"error.log", true},
+		{"web/debug.log", false},
+		{"web/error.log", true},
+		{"build/main", true},
+		{"cmd/build/main.go", false},
+		{"web/node_modules/react/index.js", true},
+		{"web/dist/app.js", true},
+		{"dist/app.js", false},
+		{"main.go", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.IsIgnored(tt.name); got != tt.want {
+				t.Errorf("IsIgnored() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnorePatterns(t *testing.T) {
+	fs := memfs.New()
+	r, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		".gitignore":     "*.log\n",
+		"web/.gitignore": "# Bundles\ndist/\n",
+		"main.go":        "package main\n",
+	}
+	for name, content := range files {
+		if err = util.WriteFile(fs, name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hash, err := w.Commit("Add files", &git.CommitOptions{
+		Author: &object.Signature{Name: "Gopher", Email: "gopher@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := r.CommitObject(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := c.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []IgnorePattern{{"", "*.log"}, {"web", "dist/"}}
+	if got := ignorePatterns(tree); !reflect.DeepEqual(got, want) {
+		t.Errorf("ignorePatterns() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package local
+
+import (
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// LFSPointerPrefix starts every Git LFS pointer file.
+const LFSPointerPrefix = "version https://git-lfs.github.com/spec/v1"
+
+// lfsPointerMaxSize is the largest a pointer file can be, larger blobs are not read.
+const lfsPointerMaxSize = 1024
+
+// IsLFSPointer checks if the file content is a Git LFS pointer.
+func IsLFSPointer(content string) bool {
+	return strings.HasPrefix(content, LFSPointerPrefix)
+}
+
+// setBlobInfo records the blob size of the file after the commit, deleted files have no blob.
+func setBlobInfo(tree *object.Tree, d *Diff) {
+	f, err := tree.File(d.Name)
+	if err != nil {
+		return
+	}
+
+	d.Size = f.Size
+	if f.Size > lfsPointerMaxSize {
+		return
+	}
+
+	if contents, err := f.Contents(); err == nil {
+		d.IsLFSPointer = IsLFSPointer(contents)
+	}
+}
+
+// ParseLFSPatterns finds the patterns of a .gitattributes file that are tracked by Git LFS,
+// eg: "*.psd filter=lfs diff=lfs merge=lfs -text" is "*.psd".
+func ParseLFSPatterns(attributes string) []string {
+	var patterns []string
+
+	for _, line := range strings.Split(attributes, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		for _, attr := range fields[1:] {
+			if attr == "filter=lfs" {
+				patterns = append(patterns, fields[0])
+
+				break
+			}
+		}
+	}
+
+	return patterns
+}
+
+// MatchesLFSPattern checks if the file is tracked by any of the LFS patterns. Following .gitattributes,
+// patterns without a slash match the file name in any directory.
+func MatchesLFSPattern(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimPrefix(strings.TrimPrefix(pattern, "**/"), "/")
+
+		target := name
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
+		} else if strings.HasSuffix(pattern, "/**") {
+			if strings.HasPrefix(name, strings.TrimSuffix(pattern, "**")) {
+				return true
+			}
+
+			continue
+		}
+
+		if ok, err := path.Match(pattern, target); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
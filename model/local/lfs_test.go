@@ -0,0 +1,113 @@
+package local
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+func TestParseLFSPatterns(t *testing.T) {
+	attributes := "# Assets\n*.psd filter=lfs diff=lfs merge=lfs -text\n*.go text eol=lf\n" +
+		"assets/** filter=lfs diff=lfs merge=lfs -text\n\n"
+
+	want := []string{"*.psd", "assets/**"}
+	if got := ParseLFSPatterns(attributes); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLFSPatterns() = %v, want %v", got, want)
+	}
+}
+
+func TestMatchesLFSPattern(t *testing.T) {
+	patterns := []string{"*.psd", "assets/**", "/docs/*.pdf"}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"art/logo.psd", true},
+		{"assets/video/intro.mp4", true},
+		{"docs/manual.pdf", true},
+		{"docs/guide/manual.pdf", false},
+		{"main.go", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesLFSPattern(tt.name, patterns); got != tt.want {
+				t.Errorf("MatchesLFSPattern() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsLFSPointer(t *testing.T) {
+	pointer := "version https://git-lfs.github.com/spec/v1\noid sha256:4d7a\nsize 12345\n"
+	if !IsLFSPointer(pointer) {
+		t.Errorf("IsLFSPointer() = false, want true")
+	}
+
+	if IsLFSPointer("package main\n") {
+		t.Errorf("IsLFSPointer() = true, want false")
+	}
+}
+
+func TestNewCommitBlobInfo(t *testing.T) {
+	fs := memfs.New()
+	r, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"main.go":   "package main\n",
+		"logo.psd":  "version https://git-lfs.github.com/spec/v1\noid sha256:4d7a\nsize 12345\n",
+		"README.md": "# go-gopher\n",
+	}
+	for name, content := range files {
+		if err = util.WriteFile(fs, name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hash, err := w.Commit("Add files", &git.CommitOptions{
+		Author: &object.Signature{Name: "Gopher", Email: "gopher@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := r.CommitObject(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit, err := NewCommit(r, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(commit.DiffToParents) != len(files) {
+		t.Fatalf("DiffToParents = %d, want %d", len(commit.DiffToParents), len(files))
+	}
+
+	for _, d := range commit.DiffToParents {
+		if d.Size != int64(len(files[d.Name])) {
+			t.Errorf("%s Size = %d, want %d", d.Name, d.Size, len(files[d.Name]))
+		}
+
+		if d.IsLFSPointer != (d.Name == "logo.psd") {
+			t.Errorf("%s IsLFSPointer = %v", d.Name, d.IsLFSPointer)
+		}
+	}
+}
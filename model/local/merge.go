@@ -0,0 +1,347 @@
+package local
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxMergeLines is the number of lines of a merge result or parent file before the file is not diffed, line diffs
+// are not bounded by a timeout as a diff cut short would report lines of the parents.
+const maxMergeLines = 10000
+
+// MergeHunk is a run of lines of a merge result that are in none of its parents, or lines of every parent the
+// merge dropped.
+type MergeHunk struct {
+	Start    int      // First line of the hunk in the merge result, the line after removed lines.
+	Added    []string // Lines in none of the parents.
+	Removed  []string // Lines in every parent that were dropped.
+	Conflict bool     // The parents had different lines here, so the hunk resolves a conflict.
+}
+
+// MergeChange is a file of a merge commit with changes from every parent, like the "++" and "--" lines of
+// "git diff --cc". Clean merges only take lines from the parents, so these are conflict resolutions or
+// unrelated changes hidden in the merge, an evil merge.
+type MergeChange struct {
+	Name  string
+	Hunks []MergeHunk
+}
+
+// parentDiff is the line diff from a parent to the merge result.
+type parentDiff struct {
+	added   []bool           // Result lines added to the parent.
+	deleted map[int][]string // Lines of the parent deleted before each result line.
+}
+
+// FetchMergeChanges finds the changes of the merge commit to every parent in the files it changed, nil for other
+// commits.
+func FetchMergeChanges(repo *git.Repository, commit *Commit) ([]MergeChange, error) {
+	if len(commit.ParentHashes) < 2 {
+		return nil, nil
+	}
+
+	c, err := repo.CommitObject(plumbing.Hash(commit.Hash))
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch merge commit: %w", err)
+	}
+
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch merge commit tree: %w", err)
+	}
+
+	names := make([]string, 0, len(commit.DiffToParents))
+	for _, d := range commit.DiffToParents {
+		if d.Name != "" && !d.IsBinary {
+			names = append(names, d.Name)
+		}
+	}
+
+	return mergeChanges(c, tree, names)
+}
+
+// mergeChanges finds the changes of the merge commit to every parent in the files, files are skipped unless they
+// differ from every parent, or when they are too large to diff.
+func mergeChanges(c *object.Commit, tree *object.Tree, names []string) ([]MergeChange, error) {
+	parents := make([]*object.Tree, 0, c.NumParents())
+	err := c.Parents().ForEach(func(p *object.Commit) error {
+		t, err := p.Tree()
+		if err != nil {
+			return err //nolint: wrapcheck
+		}
+		parents = append(parents, t)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch merge parent trees: %w", err)
+	}
+
+	var changes []MergeChange
+
+	for _, name := range names {
+		f, err := tree.File(name)
+		if err != nil {
+			// Deleted files have no lines of their own.
+			continue
+		}
+
+		if binary, berr := f.IsBinary(); berr != nil || binary {
+			continue
+		}
+
+		// Files of the parents, nil when the parent does not have the file.
+		parentFiles := make([]*object.File, len(parents))
+		same := false
+		for i, parent := range parents {
+			if pf, perr := parent.File(name); perr == nil {
+				parentFiles[i] = pf
+				same = same || pf.Hash == f.Hash
+			}
+		}
+
+		// The file is the version of one of the parents.
+		if same {
+			continue
+		}
+
+		contents, err := f.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("cannot read merge file %s: %w", name, err)
+		}
+		lines := splitContent(contents)
+
+		diffs := make([]parentDiff, 0, len(parents))
+		for _, pf := range parentFiles {
+			var from string
+			if pf != nil {
+				if from, err = pf.Contents(); err != nil {
+					return nil, fmt.Errorf("cannot read merge parent file %s: %w", name, err)
+				}
+			}
+
+			fromLines := splitContent(from)
+			if len(fromLines) > maxMergeLines || len(lines) > maxMergeLines {
+				log.Warnf("skipping merge changes of %s in %s, too large to diff", name, c.Hash)
+
+				break
+			}
+			diffs = append(diffs, newParentDiff(fromLines, lines))
+		}
+
+		// The file was too large to diff.
+		if len(diffs) != len(parents) {
+			continue
+		}
+
+		if hunks := combineDiffs(lines, diffs); len(hunks) != 0 {
+			changes = append(changes, MergeChange{Name: name, Hunks: hunks})
+		}
+	}
+
+	return changes, nil
+}
+
+// newParentDiff diffs the lines of a parent to the lines of the merge result.
+func newParentDiff(from, to []string) parentDiff {
+	pd := parentDiff{
+		added:   make([]bool, len(to)),
+		deleted: make(map[int][]string),
+	}
+
+	line := 0
+	for _, d := range diffLines(from, to) {
+		switch d.op {
+		case diffmatchpatch.DiffEqual:
+			line += len(d.lines)
+		case diffmatchpatch.DiffInsert:
+			for i := range d.lines {
+				pd.added[line+i] = true
+			}
+			line += len(d.lines)
+		case diffmatchpatch.DiffDelete:
+			pd.deleted[line] = append(pd.deleted[line], d.lines...)
+		}
+	}
+
+	return pd
+}
+
+// lineDiff is an operation on lines of a line diff.
+type lineDiff struct {
+	op    diffmatchpatch.Operation
+	lines []string
+}
+
+// diffLines diffs lines by diffing a rune per unique line. The line mode of go-diff scrambles lines in the version
+// go-git depends on.
+func diffLines(from, to []string) []lineDiff {
+	runes := make(map[string]rune)
+	var lines []string
+	encode := func(ls []string) []rune {
+		rs := make([]rune, len(ls))
+		for i, l := range ls {
+			r, ok := runes[l]
+			if !ok {
+				r = lineRune(len(lines))
+				runes[l] = r
+				lines = append(lines, l)
+			}
+			rs[i] = r
+		}
+
+		return rs
+	}
+	src, dst := encode(from), encode(to)
+
+	dmp := diffmatchpatch.New()
+	// A diff cut short by the timeout replaces the rest of the file, which would report lines of the parents. The
+	// files are bounded by maxMergeLines instead.
+	dmp.DiffTimeout = 0
+
+	var diffs []lineDiff
+	for _, d := range dmp.DiffMainRunes(src, dst, false) {
+		ld := lineDiff{op: d.Type}
+		for _, r := range d.Text {
+			ld.lines = append(ld.lines, lines[runeLine(r)])
+		}
+		diffs = append(diffs, ld)
+	}
+
+	return diffs
+}
+
+// lineRune is the rune of the nth unique line, skipping the surrogate runes that are not valid in strings.
+func lineRune(n int) rune {
+	if r := rune(n); r < surrogateMin {
+		return r
+	}
+
+	return rune(n) + surrogateMax - surrogateMin + 1
+}
+
+// runeLine is the index of the unique line of the rune.
+func runeLine(r rune) int {
+	if r < surrogateMin {
+		return int(r)
+	}
+
+	return int(r - (surrogateMax - surrogateMin + 1))
+}
+
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+// combineDiffs groups the result lines added to every parent, and the lines deleted from every parent at the same
+// result line, into hunks.
+func combineDiffs(lines []string, diffs []parentDiff) []MergeHunk {
+	var hunks []MergeHunk
+	var hunk *MergeHunk
+	var end int
+
+	for n := 0; n <= len(lines); n++ {
+		removed := commonDeleted(n, diffs)
+		added := n < len(lines)
+		for _, pd := range diffs {
+			added = added && pd.added[n]
+		}
+
+		if len(removed) == 0 && !added {
+			if hunk != nil {
+				hunk.Conflict = isConflict(hunk, end, diffs)
+				hunks = append(hunks, *hunk)
+				hunk = nil
+			}
+
+			continue
+		}
+
+		if hunk == nil {
+			hunk = &MergeHunk{Start: n + 1}
+		}
+		end = n
+		hunk.Removed = append(hunk.Removed, removed...)
+		if added {
+			hunk.Added = append(hunk.Added, lines[n])
+		}
+	}
+
+	if hunk != nil {
+		hunk.Conflict = isConflict(hunk, end, diffs)
+		hunks = append(hunks, *hunk)
+	}
+
+	return hunks
+}
+
+// commonDeleted finds the lines deleted from every parent before the result line.
+func commonDeleted(n int, diffs []parentDiff) []string {
+	common := diffs[0].deleted[n]
+	for _, pd := range diffs[1:] {
+		remaining := make(map[string]int)
+		for _, l := range pd.deleted[n] {
+			remaining[l]++
+		}
+
+		var kept []string
+		for _, l := range common {
+			if remaining[l] > 0 {
+				remaining[l]--
+				kept = append(kept, l)
+			}
+		}
+		common = kept
+	}
+
+	return common
+}
+
+// isConflict checks if both parents of a merge had lines of their own replaced by the hunk, lines that are not
+// in the other parent, so the merge had to resolve a conflict. Lines inserted into both parents, or the same lines
+// replaced in both, are unrelated changes. Octopus merges can not resolve conflicts.
+func isConflict(hunk *MergeHunk, end int, diffs []parentDiff) bool {
+	if len(diffs) != 2 {
+		return false
+	}
+
+	for _, pd := range diffs {
+		common := make(map[string]int)
+		for _, l := range hunk.Removed {
+			common[l]++
+		}
+
+		own := 0
+		for n := hunk.Start - 1; n <= end; n++ {
+			for _, l := range pd.deleted[n] {
+				if common[l] > 0 {
+					common[l]--
+
+					continue
+				}
+				own++
+			}
+		}
+
+		if own == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitContent splits file content into lines without line breaks.
+func splitContent(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
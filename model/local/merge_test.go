@@ -0,0 +1,118 @@
+package local
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+func TestMergeChanges(t *testing.T) {
+	fs := memfs.New()
+	r, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := func(files map[string][]string, parents ...plumbing.Hash) plumbing.Hash {
+		for name, lines := range files {
+			if err = util.WriteFile(fs, name, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = w.Add(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		hash, cerr := w.Commit("commit", &git.CommitOptions{
+			Author:  &object.Signature{Name: "Gopher", Email: "gopher@example.com", When: time.Now()},
+			Parents: parents,
+		})
+		if cerr != nil {
+			t.Fatal(cerr)
+		}
+
+		return hash
+	}
+
+	base := commit(map[string][]string{
+		"main.go":   {"l1", "l2", "l3", "l4", "l5", "l6", "l7", "l8", "l9", "l10"},
+		"README.md": {"# go-gopher"},
+	})
+	a := commit(map[string][]string{
+		"main.go": {"l1", "l2", "A3", "l4", "l5", "A6", "l7", "l8", "l9", "l10"},
+	}, base)
+	b := commit(map[string][]string{
+		"main.go":   {"l1", "l2", "l3", "l4", "l5", "B6", "l7", "l8", "B9", "l10"},
+		"README.md": {"# Go Gopher"},
+	}, base)
+	// Takes both clean changes, resolves the conflict on line 6, inserts EVIL and drops l8.
+	merge := commit(map[string][]string{
+		"main.go": {"l1", "EVIL", "l2", "A3", "l4", "l5", "AB6", "l7", "B9", "l10"},
+	}, a, b)
+
+	c, err := r.CommitObject(merge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	merged, err := NewCommit(r, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Merge changes are only fetched on demand.
+	if merged.MergeChanges != nil {
+		t.Errorf("NewCommit() MergeChanges = %+v, want nil", merged.MergeChanges)
+	}
+
+	got, err := FetchMergeChanges(r, merged)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []MergeChange{{
+		Name: "main.go",
+		Hunks: []MergeHunk{
+			{Start: 2, Added: []string{"EVIL"}},
+			{Start: 7, Added: []string{"AB6"}, Conflict: true},
+			{Start: 9, Removed: []string{"l8"}},
+		},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FetchMergeChanges() = %+v, want %+v", got, want)
+	}
+
+	// A clean merge only takes lines from the parents.
+	clean := commit(map[string][]string{
+		"main.go": {"l1", "l2", "A3", "l4", "l5", "A6", "l7", "l8", "B9", "l10"},
+	}, a, b)
+
+	if c, err = r.CommitObject(clean); err != nil {
+		t.Fatal(err)
+	}
+
+	if merged, err = NewCommit(r, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err = FetchMergeChanges(r, merged); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("FetchMergeChanges() = %+v, want none", got)
+	}
+}
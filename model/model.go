@@ -4,16 +4,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/model/local"
 	"github.com/Git-Gopher/go-gopher/model/remote"
 	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
 	log "github.com/sirupsen/logrus"
 )
 
-//nolint:gocognit
-func FetchEnrichedModel(repo *git.Repository, repoOwner, repoName string) (*enriched.EnrichedModel, error) {
+// FetchEnrichedModel scrapes the remote repository and loads the local repository into an enriched model.
+func FetchEnrichedModel(
+	repo *git.Repository,
+	repoOwner, repoName string,
+	roles config.BranchRoles,
+) (*enriched.EnrichedModel, error) {
 	// scraping remote GitHub repository.
 	start := time.Now()
 
@@ -25,16 +29,6 @@ func FetchEnrichedModel(repo *git.Repository, repoOwner, repoName string) (*enri
 	elapsed := time.Since(start)
 	log.Infof("Scraped remote GitHub repository in %s", elapsed)
 
-	// here we need to define which is the dev branch and which is release branch
-	// most of the time we assume that the dev branch is the default branch
-
-	// dev branch:
-	// we can determine this by the most number of PR merged into the branch is the dev branch
-	devBranch := findDevBranchByPR(githubModel.PullRequests)
-
-	// release branch:
-	// we can determine this by the most number of tags is the release branch
-
 	// loading local Git repository.
 	start = time.Now()
 
@@ -47,68 +41,9 @@ func FetchEnrichedModel(repo *git.Repository, repoOwner, repoName string) (*enri
 
 	enrichedModel := enriched.NewEnrichedModel(*gitModel, *githubModel)
 
-	// if the main branch isn't the dev branch, we need to find the dev branch
-	if devBranch != nil && gitModel.MainGraph.BranchName != *devBranch {
-		enrichedModel.ReleaseGraph = gitModel.MainGraph // the main branch is the release branch
-		var headCommit local.Hash
-		for _, branch := range gitModel.Branches {
-			// remote branch name has origin/ as prefix
-			if branch.Name == *devBranch {
-				headCommit = branch.Head.Hash
-
-				break
-			}
-		}
-
-		refCommit, err := repo.CommitObject(plumbing.NewHash(headCommit.HexString()))
-		if err != nil {
-			return nil, fmt.Errorf("failed to get commit object: %w", err)
-		}
-
-		enrichedModel.MainGraph = local.FetchBranchGraph(refCommit)
-		enrichedModel.MainGraph.BranchName = *devBranch
-	}
-
-	// if the default branch is not release branch, try find release branch
-	if enrichedModel.ReleaseGraph == nil { //nolint:nestif
-		tags, err := repo.Tags()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get tags: %w", err)
-		}
-
-		revHashMap := make(map[string]struct{})
-
-		err = tags.ForEach(func(t *plumbing.Reference) error {
-			// This technique should work for both lightweight and annotated tags.
-			revHash, err2 := repo.ResolveRevision(plumbing.Revision(t.Name()))
-			if err != nil {
-				return fmt.Errorf("failed to resolve revision: %w", err2)
-			}
-
-			revHashMap[revHash.String()] = struct{}{}
-
-			return nil
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to iterate tags: %w", err)
-		}
-
-		for _, branch := range enrichedModel.Branches {
-			if _, ok := revHashMap[branch.Head.Hash.HexString()]; ok {
-				if branch.Name == gitModel.MainGraph.BranchName {
-					continue
-				}
-
-				refCommit, err := repo.CommitObject(plumbing.NewHash(branch.Head.Hash.HexString()))
-				if err != nil {
-					return nil, fmt.Errorf("failed to get commit object: %w", err)
-				}
-
-				enrichedModel.ReleaseGraph = local.FetchBranchGraph(refCommit)
-
-				break
-			}
-		}
+	// assign branch roles, inferring the dev and release branches when not configured.
+	if err = enrichedModel.AssignBranchRoles(roles); err != nil {
+		return nil, fmt.Errorf("failed to assign branch roles: %w", err)
 	}
 
 	log.Infof("Main/Dev Branch: %s", enrichedModel.MainGraph.BranchName)
@@ -119,36 +54,19 @@ func FetchEnrichedModel(repo *git.Repository, repoOwner, repoName string) (*enri
 	return enrichedModel, nil
 }
 
-// findDevBranchByPR finds the dev branch by the most number of PR merged into the branch.
-func findDevBranchByPR(prs []*remote.PullRequest) *string {
-	if len(prs) == 0 {
-		return nil
-	}
-
-	count := map[string]int{}
-	for _, pr := range prs {
-		if !pr.Merged {
-			continue
-		}
-
-		if _, ok := count[pr.BaseRefName]; !ok {
-			count[pr.BaseRefName] = 0
-		}
-		count[pr.BaseRefName]++
+// LoadEnrichedModel imports an exported enriched model and reassigns the branch roles from the config.
+func LoadEnrichedModel(path string, roles config.BranchRoles) (*enriched.EnrichedModel, error) {
+	enrichedModel, header, err := enriched.ImportFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import enriched model: %w", err)
 	}
 
-	if len(count) == 0 {
-		return nil
-	}
+	log.Infof("Imported %s/%s exported by %s at %s",
+		header.Owner, header.Name, header.GopherVersion, header.Created.Format(time.RFC3339))
 
-	max := 0
-	var devBranch string
-	for branch, c := range count {
-		if c > max {
-			max = c
-			devBranch = branch
-		}
+	if err = enrichedModel.AssignBranchRoles(roles); err != nil {
+		return nil, fmt.Errorf("failed to assign branch roles: %w", err)
 	}
 
-	return &devBranch
+	return enrichedModel, nil
 }
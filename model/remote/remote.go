@@ -14,13 +14,20 @@ type Author struct {
 }
 
 type Issue struct {
-	Id          string
-	Number      int
-	Title       string
-	Body        string
-	State       string
-	StateReason string
-	Author      *Author
+	Id                  string
+	Number              int
+	Title               string
+	Body                string
+	State               string
+	StateReason         string
+	Author              *Author
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	ClosedAt            *time.Time
+	Labels              []string
+	Assignees           []string // Logins of the assignees.
+	ClosedByCommit      string   // Commit that closed the issue, eg: "Fixes #1" pushed to the default branch.
+	ClosedByPullRequest int      // Pull request that closed the issue, zero when not closed by one.
 }
 
 type ReviewThread struct {
@@ -30,11 +37,32 @@ type ReviewThread struct {
 	Path       string
 }
 
+type PullRequestCommit struct {
+	Oid             string
+	MessageHeadline string
+}
+
+// Review is an approving review of a pull request.
+type Review struct {
+	Author      *Author
+	SubmittedAt *time.Time
+	CommitOid   string // Head commit of the pull request when the review was submitted.
+}
+
+// PullRequestFile is a file changed by a pull request.
+type PullRequestFile struct {
+	Path      string
+	Additions int
+	Deletions int
+}
+
 type PullRequest struct {
 	Id             string
 	Number         int
 	HeadRefName    string // source branch
 	BaseRefName    string // target branch
+	BaseRefOid     string // head commit of the target branch when the pull request was last updated
+	HeadRefOid     string // head commit of the source branch
 	CreatedAt      *time.Time
 	ClosedAt       *time.Time
 	Title          string
@@ -43,10 +71,17 @@ type PullRequest struct {
 	Closed         bool
 	Merged         bool
 	MergedBy       *Author
+	MergeCommit    string // commit created on the target branch when merged
 	Url            string
 	Author         *Author
 	ClosingIssues  []*Issue
 	ReviewThreads  []*ReviewThread
+	Approvals      []*Review
+	Commits        []*PullRequestCommit
+	Additions      int // Lines added, including generated files.
+	Deletions      int // Lines deleted, including generated files.
+	ChangedFiles   int
+	Files          []*PullRequestFile
 }
 
 type RemoteModel struct {
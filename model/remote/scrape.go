@@ -61,6 +61,7 @@ func (s *Scraper) FetchPullRequestClosingIssues(
 				ClosingIssuesReferences struct {
 					Nodes []struct {
 						Id     string
+						Number int
 						Title  string
 						Body   string
 						Author struct {
@@ -93,9 +94,10 @@ func (s *Scraper) FetchPullRequestClosingIssues(
 
 		for _, i := range q.Repository.PullRequest.ClosingIssuesReferences.Nodes {
 			issue := Issue{
-				Id:    i.Id,
-				Title: i.Title,
-				Body:  i.Body,
+				Id:     i.Id,
+				Number: i.Number,
+				Title:  i.Title,
+				Body:   i.Body,
 				Author: &Author{
 					Login:     i.Author.Login,
 					AvatarUrl: i.Author.AvatarUrl,
@@ -116,6 +118,116 @@ func (s *Scraper) FetchPullRequestClosingIssues(
 	return all, nil
 }
 
+// Fetch the remaining changed files of a pull request after the cursor.
+func (s *Scraper) FetchPullRequestFiles(
+	ctx context.Context,
+	owner,
+	name string,
+	number int,
+	cursor string,
+) ([]*PullRequestFile, error) {
+	var q struct {
+		Repository struct {
+			PullRequest struct {
+				Files struct {
+					Nodes []struct {
+						Path      string
+						Additions int
+						Deletions int
+					}
+					PageInfo PageInfo
+				} `graphql:"files(first: $first, after: $cursor)"`
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	var all []*PullRequestFile
+	variables := map[string]interface{}{
+		"number": githubv4.Int(number),
+		"first":  githubv4.Int(githubQuerySize),
+		"cursor": githubv4.String(cursor),
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+	}
+
+	for {
+		if err := s.Client.Query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("Failed to fetch additional pull request files: %w", err)
+		}
+
+		for _, f := range q.Repository.PullRequest.Files.Nodes {
+			all = append(all, &PullRequestFile{
+				Path:      f.Path,
+				Additions: f.Additions,
+				Deletions: f.Deletions,
+			})
+		}
+
+		if !q.Repository.PullRequest.Files.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["cursor"] = githubv4.NewString(q.Repository.PullRequest.Files.PageInfo.EndCursor)
+	}
+
+	return all, nil
+}
+
+// Fetch the remaining commits of a pull request after the cursor.
+func (s *Scraper) FetchPullRequestCommits(
+	ctx context.Context,
+	owner,
+	name string,
+	number int,
+	cursor string,
+) ([]*PullRequestCommit, error) {
+	var q struct {
+		Repository struct {
+			PullRequest struct {
+				Commits struct {
+					Nodes []struct {
+						Commit struct {
+							Oid             string
+							MessageHeadline string
+						}
+					}
+					PageInfo PageInfo
+				} `graphql:"commits(first: $first, after: $cursor)"`
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	var all []*PullRequestCommit
+	variables := map[string]interface{}{
+		"number": githubv4.Int(number),
+		"first":  githubv4.Int(githubQuerySize),
+		"cursor": githubv4.String(cursor),
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+	}
+
+	for {
+		if err := s.Client.Query(ctx, &q, variables); err != nil {
+			return nil, fmt.Errorf("Failed to fetch additional pull request commits: %w", err)
+		}
+
+		for _, c := range q.Repository.PullRequest.Commits.Nodes {
+			all = append(all, &PullRequestCommit{
+				Oid:             c.Commit.Oid,
+				MessageHeadline: c.Commit.MessageHeadline,
+			})
+		}
+
+		if !q.Repository.PullRequest.Commits.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["cursor"] = githubv4.NewString(q.Repository.PullRequest.Commits.PageInfo.EndCursor)
+	}
+
+	return all, nil
+}
+
 func (s *Scraper) FetchIssues(ctx context.Context, owner, name string) ([]*Issue, error) {
 	var q struct {
 		Repository struct {
@@ -135,6 +247,34 @@ func (s *Scraper) FetchIssues(ctx context.Context, owner, name string) ([]*Issue
 							Email string
 						} `graphql:"... on User"`
 					}
+					CreatedAt string
+					UpdatedAt string
+					ClosedAt  string
+					Labels    struct {
+						Nodes []struct {
+							Name string
+						}
+					} `graphql:"labels(first: 20)"`
+					Assignees struct {
+						Nodes []struct {
+							Login string
+						}
+					} `graphql:"assignees(first: 10)"`
+					// Latest close of the issue, issues can be reopened and closed again.
+					TimelineItems struct {
+						Nodes []struct {
+							ClosedEvent struct {
+								Closer struct {
+									Commit struct {
+										Oid string
+									} `graphql:"... on Commit"`
+									PullRequest struct {
+										Number int
+									} `graphql:"... on PullRequest"`
+								}
+							} `graphql:"... on ClosedEvent"`
+						}
+					} `graphql:"timelineItems(itemTypes: [CLOSED_EVENT], last: 1)"`
 				}
 				PageInfo PageInfo
 			} `graphql:"issues(first: $first, after: $cursor)"`
@@ -155,7 +295,7 @@ func (s *Scraper) FetchIssues(ctx context.Context, owner, name string) ([]*Issue
 		}
 
 		for _, is := range q.Repository.Issues.Nodes {
-			all = append(all, &Issue{
+			issue := &Issue{
 				Id:          is.Id,
 				Number:      is.Number,
 				Title:       is.Title,
@@ -167,7 +307,33 @@ func (s *Scraper) FetchIssues(ctx context.Context, owner, name string) ([]*Issue
 					AvatarUrl: is.Author.AvatarUrl,
 					Email:     is.Author.User.Email,
 				},
-			})
+				Labels:    make([]string, len(is.Labels.Nodes)),
+				Assignees: make([]string, len(is.Assignees.Nodes)),
+			}
+
+			var err error
+			if issue.CreatedAt, err = parseTime(is.CreatedAt); err != nil {
+				return nil, fmt.Errorf("could not parse ISO time for issue %d creation: %w", is.Number, err)
+			}
+			if issue.UpdatedAt, err = parseTime(is.UpdatedAt); err != nil {
+				return nil, fmt.Errorf("could not parse ISO time for issue %d update: %w", is.Number, err)
+			}
+			if issue.ClosedAt, err = parseTime(is.ClosedAt); err != nil {
+				return nil, fmt.Errorf("could not parse ISO time for issue %d close: %w", is.Number, err)
+			}
+
+			for i, l := range is.Labels.Nodes {
+				issue.Labels[i] = l.Name
+			}
+			for i, a := range is.Assignees.Nodes {
+				issue.Assignees[i] = a.Login
+			}
+			for _, item := range is.TimelineItems.Nodes {
+				issue.ClosedByCommit = item.ClosedEvent.Closer.Commit.Oid
+				issue.ClosedByPullRequest = item.ClosedEvent.Closer.PullRequest.Number
+			}
+
+			all = append(all, issue)
 		}
 
 		if !q.Repository.Issues.PageInfo.HasNextPage {
@@ -180,6 +346,20 @@ func (s *Scraper) FetchIssues(ctx context.Context, owner, name string) ([]*Issue
 	return all, nil
 }
 
+// parseTime parses an ISO 8601 time, empty times are nil.
+func parseTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil //nolint: nilnil
+	}
+
+	t, err := time.Parse("2006-01-02T15:04:05Z0700", s)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse time %q: %w", s, err)
+	}
+
+	return &t, nil
+}
+
 // Fetch all pull requests associated with the repository.
 func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]*PullRequest, error) {
 	var q struct {
@@ -191,6 +371,8 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 					Number         int
 					HeadRefName    string
 					BaseRefName    string
+					BaseRefOid     string
+					HeadRefOid     string
 					Title          string
 					Body           string
 					ClosedAt       string
@@ -198,6 +380,9 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 					ReviewDecision string
 					Merged         bool
 					Closed         bool
+					Additions      int
+					Deletions      int
+					ChangedFiles   int
 					MergedBy       struct {
 						Login     string
 						AvatarUrl string
@@ -205,6 +390,9 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 							Email string
 						} `graphql:"... on User"`
 					}
+					MergeCommit struct {
+						Oid string
+					}
 					Url string
 					// Author
 					Author struct {
@@ -218,6 +406,7 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 					ClosingIssuesReferences struct {
 						Nodes []struct {
 							Id     string
+							Number int
 							Title  string
 							Body   string
 							Author struct {
@@ -239,6 +428,41 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 							Path       string
 						}
 					} `graphql:"reviewThreads(first: 100)"`
+					// XXX: Limited to the first 100 approvals, pull requests rarely have more.
+					Reviews struct {
+						Nodes []struct {
+							SubmittedAt string
+							Commit      struct {
+								Oid string
+							}
+							Author struct {
+								Login     string
+								AvatarUrl string
+								User      struct {
+									Email string
+								} `graphql:"... on User"`
+							}
+						}
+					} `graphql:"reviews(first: 100, states: APPROVED)"`
+					// Commits
+					Commits struct {
+						Nodes []struct {
+							Commit struct {
+								Oid             string
+								MessageHeadline string
+							}
+						}
+						PageInfo PageInfo
+					} `graphql:"commits(first: $first)"`
+					// Files
+					Files struct {
+						Nodes []struct {
+							Path      string
+							Additions int
+							Deletions int
+						}
+						PageInfo PageInfo
+					} `graphql:"files(first: $first)"`
 				}
 				PageInfo PageInfo
 			} `graphql:"pullRequests(first: $first, after: $cursor)"`
@@ -289,6 +513,8 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 				Number:         mpr.Number,
 				HeadRefName:    mpr.HeadRefName,
 				BaseRefName:    mpr.BaseRefName,
+				BaseRefOid:     mpr.BaseRefOid,
+				HeadRefOid:     mpr.HeadRefOid,
 				CreatedAt:      createdAt,
 				ClosedAt:       closedAt,
 				Title:          mpr.Title,
@@ -301,7 +527,8 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 					AvatarUrl: mpr.MergedBy.AvatarUrl,
 					Email:     mpr.MergedBy.User.Email,
 				},
-				Url: mpr.Url,
+				MergeCommit: mpr.MergeCommit.Oid,
+				Url:         mpr.Url,
 				Author: &Author{
 					Login:     mpr.Author.Login,
 					AvatarUrl: mpr.Author.AvatarUrl,
@@ -309,15 +536,21 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 				},
 				ClosingIssues: nil,
 				ReviewThreads: nil,
+				Commits:       nil,
+				Additions:     mpr.Additions,
+				Deletions:     mpr.Deletions,
+				ChangedFiles:  mpr.ChangedFiles,
+				Files:         nil,
 			}
 
 			// Closing issues
 			var cis []*Issue = make([]*Issue, len(mpr.ClosingIssuesReferences.Nodes))
 			for i, ci := range mpr.ClosingIssuesReferences.Nodes {
 				cis[i] = &Issue{
-					Id:    ci.Id,
-					Title: ci.Title,
-					Body:  ci.Body,
+					Id:     ci.Id,
+					Number: ci.Number,
+					Title:  ci.Title,
+					Body:   ci.Body,
 					Author: &Author{
 						Login:     ci.Author.Login,
 						AvatarUrl: ci.Author.AvatarUrl,
@@ -353,6 +586,73 @@ func (s *Scraper) FetchPullRequests(ctx context.Context, owner, name string) ([]
 
 			pr.ReviewThreads = rs
 
+			// Approvals
+			var as []*Review = make([]*Review, 0, len(mpr.Reviews.Nodes))
+			for _, r := range mpr.Reviews.Nodes {
+				// Pending reviews have not been submitted.
+				if r.SubmittedAt == "" {
+					continue
+				}
+
+				submitted, err := time.Parse(layout, r.SubmittedAt)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse ISO time for PR review: %w", err)
+				}
+
+				as = append(as, &Review{
+					Author: &Author{
+						Login:     r.Author.Login,
+						AvatarUrl: r.Author.AvatarUrl,
+						Email:     r.Author.User.Email,
+					},
+					SubmittedAt: &submitted,
+					CommitOid:   r.Commit.Oid,
+				})
+			}
+
+			pr.Approvals = as
+
+			// Commits
+			var cs []*PullRequestCommit = make([]*PullRequestCommit, len(mpr.Commits.Nodes))
+			for i, c := range mpr.Commits.Nodes {
+				cs[i] = &PullRequestCommit{
+					Oid:             c.Commit.Oid,
+					MessageHeadline: c.Commit.MessageHeadline,
+				}
+			}
+
+			if mpr.Commits.PageInfo.HasNextPage {
+				acs, err := s.FetchPullRequestCommits(ctx, owner, name, pr.Number, string(mpr.Commits.PageInfo.EndCursor))
+				if err != nil {
+					return nil, fmt.Errorf("Failed to fetch pull request commits: %w", err)
+				}
+
+				cs = append(cs, acs...)
+			}
+
+			pr.Commits = cs
+
+			// Files
+			var fs []*PullRequestFile = make([]*PullRequestFile, len(mpr.Files.Nodes))
+			for i, f := range mpr.Files.Nodes {
+				fs[i] = &PullRequestFile{
+					Path:      f.Path,
+					Additions: f.Additions,
+					Deletions: f.Deletions,
+				}
+			}
+
+			if mpr.Files.PageInfo.HasNextPage {
+				afs, err := s.FetchPullRequestFiles(ctx, owner, name, pr.Number, string(mpr.Files.PageInfo.EndCursor))
+				if err != nil {
+					return nil, fmt.Errorf("Failed to fetch pull request files: %w", err)
+				}
+
+				fs = append(fs, afs...)
+			}
+
+			pr.Files = fs
+
 			all = append(all, &pr)
 		}
 
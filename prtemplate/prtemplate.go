@@ -0,0 +1,206 @@
+package prtemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kind of problem with a pull request description.
+type Kind int
+
+const (
+	// EmptyBody is a description without any text besides comments.
+	EmptyBody Kind = iota
+	// MissingSection is a section of the template that was removed.
+	MissingSection
+	// UnfilledSection is a section left empty or with the placeholder text of the template.
+	UnfilledSection
+	// UncheckedItem is a mandatory checklist item of the template that was not checked.
+	UncheckedItem
+)
+
+// Kind string lookup.
+func (k Kind) String() string {
+	return [...]string{
+		"empty-body",
+		"missing-section",
+		"unfilled-section",
+		"unchecked-item",
+	}[k]
+}
+
+var (
+	commentPattern   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	headingPattern   = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	checklistPattern = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	optionalPattern  = regexp.MustCompile(`(?i)\boptional\b`)
+)
+
+// Section is a heading of the template and the text below it.
+type Section struct {
+	Heading  string
+	Content  string // Text of the section without comments and checklist items, eg: placeholder text.
+	Optional bool   // Headings marked optional do not have to be kept or filled.
+	Items    []Item
+}
+
+// Item is a checklist item.
+type Item struct {
+	Text      string
+	Checked   bool
+	Mandatory bool // Items marked optional, or within optional sections, do not have to be checked.
+}
+
+// Template is a parsed pull request template. Text before the first heading is a section without a heading.
+type Template struct {
+	Sections []Section
+}
+
+// Parse parses a markdown pull request template or description.
+func Parse(markdown string) Template {
+	markdown = commentPattern.ReplaceAllString(strings.ReplaceAll(markdown, "\r\n", "\n"), "")
+
+	sections := []Section{{}}
+	var content []string
+
+	flush := func() {
+		sections[len(sections)-1].Content = strings.TrimSpace(strings.Join(content, "\n"))
+		content = nil
+	}
+
+	fenced := false
+	for _, line := range strings.Split(markdown, "\n") {
+		// Lines within code blocks are content, eg: shell comments.
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+		}
+
+		if match := headingPattern.FindStringSubmatch(line); match != nil && !fenced {
+			flush()
+			sections = append(sections, Section{
+				Heading:  match[1],
+				Optional: optionalPattern.MatchString(match[1]),
+			})
+
+			continue
+		}
+
+		section := &sections[len(sections)-1]
+		if match := checklistPattern.FindStringSubmatch(line); match != nil && !fenced {
+			section.Items = append(section.Items, Item{
+				Text:      strings.TrimSpace(match[2]),
+				Checked:   match[1] != " ",
+				Mandatory: !section.Optional && !optionalPattern.MatchString(match[2]),
+			})
+
+			continue
+		}
+
+		content = append(content, line)
+	}
+	flush()
+
+	// Drop the section before the first heading when it is empty.
+	if s := sections[0]; s.Content == "" && len(s.Items) == 0 {
+		sections = sections[1:]
+	}
+
+	return Template{Sections: sections}
+}
+
+// Problem is a way a description does not follow the template.
+type Problem struct {
+	Kind    Kind
+	Message string
+}
+
+// Check checks a pull request description against the template, a nil template only checks the body is not empty.
+func Check(body string, template *Template) []Problem {
+	if strings.TrimSpace(commentPattern.ReplaceAllString(body, "")) == "" {
+		return []Problem{{Kind: EmptyBody, Message: "description is empty"}}
+	}
+
+	if template == nil {
+		return nil
+	}
+
+	description := Parse(body)
+	sections := make(map[string]Section, len(description.Sections))
+	checked := make(map[string]bool)
+
+	for _, s := range description.Sections {
+		sections[normalize(s.Heading)] = s
+		for _, item := range s.Items {
+			checked[normalize(item.Text)] = checked[normalize(item.Text)] || item.Checked
+		}
+	}
+
+	var problems []Problem
+
+	for _, ts := range template.Sections {
+		s, ok := sections[normalize(ts.Heading)]
+
+		switch {
+		// Text before the first heading is not a section of its own.
+		case ts.Optional || ts.Heading == "":
+		case !ok:
+			problems = append(problems, Problem{
+				Kind:    MissingSection,
+				Message: fmt.Sprintf("section \"%s\" is missing", ts.Heading),
+			})
+		// Sections with only a checklist have nothing to fill in.
+		case (ts.Content != "" || len(ts.Items) == 0) &&
+			(s.Content == "" || normalize(s.Content) == normalize(ts.Content)):
+			problems = append(problems, Problem{
+				Kind:    UnfilledSection,
+				Message: fmt.Sprintf("section \"%s\" was not filled in", ts.Heading),
+			})
+		}
+
+		for _, item := range ts.Items {
+			if item.Mandatory && !checked[normalize(item.Text)] {
+				problems = append(problems, Problem{
+					Kind:    UncheckedItem,
+					Message: fmt.Sprintf("checklist item \"%s\" is not checked", item.Text),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// Best is the template the description follows most closely, by the number of its headings used.
+// Repositories with multiple templates let the author pick one.
+func Best(body string, templates []Template) *Template {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	headings := make(map[string]bool)
+	for _, s := range Parse(body).Sections {
+		headings[normalize(s.Heading)] = true
+	}
+
+	best, bestScore := 0, -1
+	for i, t := range templates {
+		score := 0
+		for _, s := range t.Sections {
+			if headings[normalize(s.Heading)] {
+				score++
+			}
+		}
+
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+
+	return &templates[best]
+}
+
+// normalize collapses whitespace and case so that reformatted text still matches.
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
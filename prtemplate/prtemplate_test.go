@@ -0,0 +1,88 @@
+package prtemplate
+
+import (
+	"reflect"
+	"testing"
+)
+
+const template = `<!-- Thanks for contributing! -->
+## Description
+<!-- What does this change and why? -->
+
+## How has this been tested?
+Describe the tests you ran.
+
+## Screenshots (optional)
+
+## Checklist
+- [ ] I have added tests
+- [ ] I have updated the documentation (optional)
+`
+
+func TestCheck(t *testing.T) {
+	tpl := Parse(template)
+
+	tests := []struct {
+		name string
+		body string
+		want []Kind
+	}{
+		{
+			"filled",
+			"## Description\nAdd pagination.\n\n## How has this been tested?\nUnit tests.\n\n" +
+				"## Checklist\n- [x] I have added tests\n- [ ] I have updated the documentation (optional)\n",
+			nil,
+		},
+		{"empty", "<!-- What does this change and why? -->\n\n", []Kind{EmptyBody}},
+		{
+			"unchanged",
+			template,
+			[]Kind{UnfilledSection, UnfilledSection, UncheckedItem},
+		},
+		{
+			"missing",
+			"## Description\nAdd pagination.\n\n```sh\n# not a heading\n```\n- [X] I have added tests\n",
+			[]Kind{MissingSection, MissingSection},
+		},
+		{
+			"reformatted",
+			"### description\nAdd pagination.\n### How has  this been tested?\nManually.\n## Checklist\n" +
+				"* [x] I have added tests",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var kinds []Kind
+			for _, p := range Check(tt.body, &tpl) {
+				kinds = append(kinds, p.Kind)
+			}
+
+			if !reflect.DeepEqual(kinds, tt.want) {
+				t.Errorf("Check() = %v, want %v", kinds, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckWithoutTemplate(t *testing.T) {
+	if problems := Check("Add pagination", nil); len(problems) != 0 {
+		t.Errorf("Check() = %v, want none", problems)
+	}
+
+	if problems := Check(" \n", nil); len(problems) != 1 || problems[0].Kind != EmptyBody {
+		t.Errorf("Check() = %v, want %v", problems, EmptyBody)
+	}
+}
+
+func TestBest(t *testing.T) {
+	templates := []Template{Parse("## Feature\n## Motivation\n"), Parse("## Bug\n## Steps to reproduce\n")}
+
+	if got := Best("## Bug\nCrash\n## Steps to reproduce\nClick", templates); got != &templates[1] {
+		t.Errorf("Best() = %+v, want the bug template", got)
+	}
+
+	if got := Best("Crash", nil); got != nil {
+		t.Errorf("Best() = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,124 @@
+package secret
+
+import (
+	"math"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Rule matches a secret of a provider, the secret is the last submatch of the pattern.
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// Rules are the provider rules lines are scanned with.
+var Rules = []Rule{
+	{"AWS access key", regexp.MustCompile(`\b((?:AKIA|ASIA|AGPA|AIDA|AROA)[0-9A-Z]{16})\b`)},
+	{"AWS secret key", regexp.MustCompile(`(?i)aws.{0,20}?(?:secret|private).{0,20}?['"\s:=]([0-9a-zA-Z/+]{40})\b`)},
+	{"GitHub token", regexp.MustCompile(`\b((?:ghp|gho|ghu|ghs|ghr)_[0-9A-Za-z]{36}|github_pat_[0-9A-Za-z_]{82})\b`)},
+	{"Slack token", regexp.MustCompile(`\b(xox[abposr]-[0-9A-Za-z-]{10,72})`)},
+	{"Slack webhook", regexp.MustCompile(`(https://hooks\.slack\.com/services/T[0-9A-Z]+/B[0-9A-Z]+/[0-9A-Za-z]+)`)},
+	{"Google API key", regexp.MustCompile(`\b(AIza[0-9A-Za-z_-]{35})\b`)},
+	{"Private key", regexp.MustCompile(`(-----BEGIN (?:RSA |DSA |EC |OPENSSH |PGP |ENCRYPTED )?PRIVATE KEY(?: BLOCK)?-----)`)},
+}
+
+// GenericRule is the name of secrets found by assignment to a secret sounding name.
+const GenericRule = "High entropy secret"
+
+// assignmentPattern matches values assigned to secret sounding names, eg: API_KEY="...".
+var assignmentPattern = regexp.MustCompile(
+	`(?i)(?:api[_-]?key|secret|token|passw(?:or)?d|credential|auth)[\w-]*['"]?\s*[:=]+\s*['"]?([0-9A-Za-z+/_.=-]{16,})`,
+)
+
+// DefaultFilenames are sensitive file names and glob patterns.
+var DefaultFilenames = []string{
+	".env", ".env.*", "*.pem", "*.key", "*.p12", "*.pfx", "*.keystore", "*.jks",
+	"id_rsa", "id_dsa", "id_ecdsa", "id_ed25519", ".htpasswd", ".npmrc", ".pypirc", "credentials.json",
+}
+
+// templateSuffixes mark files that document secrets without containing them, eg: .env.example.
+var templateSuffixes = []string{".example", ".sample", ".template", ".dist"}
+
+// Finding is a secret found within a line.
+type Finding struct {
+	Rule   string
+	Secret string
+}
+
+// Scan finds secrets within a line. Values assigned to secret sounding names are secrets
+// when their entropy is at least the threshold.
+func Scan(line string, threshold float64) []Finding {
+	var findings []Finding
+
+	for _, r := range Rules {
+		for _, match := range r.Pattern.FindAllStringSubmatch(line, -1) {
+			findings = append(findings, Finding{Rule: r.Name, Secret: match[len(match)-1]})
+		}
+	}
+
+	// Provider rules are more precise than entropy.
+	if len(findings) != 0 {
+		return findings
+	}
+
+	for _, match := range assignmentPattern.FindAllStringSubmatch(line, -1) {
+		if value := match[1]; Entropy(value) >= threshold {
+			findings = append(findings, Finding{Rule: GenericRule, Secret: value})
+		}
+	}
+
+	return findings
+}
+
+// Entropy is the Shannon entropy of the string in bits per character.
+func Entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, n := range counts {
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// IsSensitiveFile checks if the base name of the file matches any of the patterns.
+// Templates of sensitive files such as .env.example are not sensitive.
+func IsSensitiveFile(name string, patterns []string) bool {
+	base := path.Base(name)
+	for _, suffix := range templateSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return false
+		}
+	}
+
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, base); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Redact hides all but the start of the secret so it is not leaked again by the report.
+func Redact(secret string) string {
+	const visible = 4
+	if len(secret) <= visible*2 {
+		return strings.Repeat("*", len(secret))
+	}
+
+	return secret[:visible] + strings.Repeat("*", len(secret)-visible)
+}
@@ -306,22 +306,29 @@ func Confirm(s string, tries int) bool {
 
 // Fetch custom or default config. Fatal on bad custom config.
 func ReadConfig(ctx *cli.Context) *config.Config {
-	var cfg *config.Config
-	var err error
+	cfg, err := LoadConfig(ctx.String("config"))
+	if err != nil {
+		log.Fatalf("Failed to read config: %v", err)
+	}
 
-	// Custom config
-	if ctx.String("config") != "" {
-		cfg, err = config.Read(ctx.String("config"))
-		if err != nil {
-			log.Fatalf("Failed to read custom config: %v", err)
-		}
-	} else {
-		// Use default config
-		cfg, err = config.Default()
+	return cfg
+}
+
+// LoadConfig reads the custom config at the path, or the default config when the path is empty.
+func LoadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		cfg, err := config.Default()
 		if err != nil {
-			log.Fatalf("Failed to read default config: %v", err)
+			return nil, fmt.Errorf("failed to read default config: %w", err)
 		}
+
+		return cfg, nil
 	}
 
-	return cfg
+	cfg, err := config.Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom config: %w", err)
+	}
+
+	return cfg, nil
 }
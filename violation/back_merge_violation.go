@@ -0,0 +1,67 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewBackMergeViolation(
+	commit markup.Commit,
+	source string,
+	target string,
+	severity Severity,
+	email string,
+	time time.Time,
+	current bool,
+) *BackMergeViolation {
+	violation := &BackMergeViolation{
+		violation: violation{
+			name:     "BackMergeViolation",
+			email:    email,
+			time:     time,
+			severity: severity,
+			current:  current,
+		},
+		commit: commit,
+		source: source,
+		target: target,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// BackMergeViolation is violation when a long lived branch is merged into a feature branch.
+type BackMergeViolation struct {
+	violation
+	*display
+	commit markup.Commit
+	source string // Long lived branch that was merged, empty when not known.
+	target string // Feature branch that was merged into, empty when not known.
+}
+
+// Message implements Violation.
+func (bmv *BackMergeViolation) Message() string {
+	source, target := bmv.source, bmv.target
+	if source == "" {
+		source = "a long lived branch"
+	} else {
+		source = fmt.Sprintf("\"%s\"", source)
+	}
+
+	if target == "" {
+		target = "a feature branch"
+	} else {
+		target = fmt.Sprintf("\"%s\"", target)
+	}
+
+	return fmt.Sprintf("Commit %s merges %s back into %s", bmv.commit.Markdown(), source, target)
+}
+
+// Suggestion implements Violation.
+func (bmv *BackMergeViolation) Suggestion() (string, error) {
+	return "Rebase the feature branch onto the branch it will be merged into instead of merging that branch into it, " +
+		"eg: \"git pull --rebase origin main\". Repeated back merges tangle the history of the feature", nil
+}
@@ -0,0 +1,82 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewBranchDivergenceViolation(
+	branch markup.Branch,
+	base string,
+	ahead int,
+	behind int,
+	age time.Duration,
+	farBehind bool,
+	longLived bool,
+	email string,
+	time time.Time,
+	current bool,
+) *BranchDivergenceViolation {
+	violation := &BranchDivergenceViolation{
+		violation: violation{
+			name:     "BranchDivergenceViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		branch:    branch,
+		base:      base,
+		ahead:     ahead,
+		behind:    behind,
+		age:       age,
+		farBehind: farBehind,
+		longLived: longLived,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// BranchDivergenceViolation is violation when a branch is far behind the branch it merges into, or has lived too long.
+type BranchDivergenceViolation struct {
+	violation
+	*display
+	branch    markup.Branch
+	base      string
+	ahead     int
+	behind    int
+	age       time.Duration
+	farBehind bool
+	longLived bool
+}
+
+// Message implements Violation.
+func (bdv *BranchDivergenceViolation) Message() string {
+	days := int(bdv.age.Hours() / 24)
+
+	switch {
+	case bdv.farBehind && bdv.longLived:
+		return fmt.Sprintf("Branch %s forked from \"%s\" %d days ago and is %d commits behind, %d commits ahead",
+			bdv.branch.Markdown(), bdv.base, days, bdv.behind, bdv.ahead)
+	case bdv.farBehind:
+		return fmt.Sprintf("Branch %s is %d commits behind \"%s\", %d commits ahead",
+			bdv.branch.Markdown(), bdv.behind, bdv.base, bdv.ahead)
+	default:
+		return fmt.Sprintf("Branch %s forked from \"%s\" %d days ago and has not been merged",
+			bdv.branch.Markdown(), bdv.base, days)
+	}
+}
+
+// Suggestion implements Violation.
+func (bdv *BranchDivergenceViolation) Suggestion() (string, error) {
+	if bdv.farBehind {
+		return fmt.Sprintf("Merge or rebase \"%s\" into the branch to resolve conflicts early, "+
+			"and merge the branch in smaller pieces", bdv.base), nil
+	}
+
+	return fmt.Sprintf("Split the work into smaller branches that can be merged into \"%s\" sooner, "+
+		"or hide unfinished work behind a feature flag", bdv.base), nil
+}
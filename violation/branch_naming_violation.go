@@ -0,0 +1,73 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewBranchNamingViolation(
+	branch markup.Branch,
+	role string,
+	rules []string,
+	issue int,
+	email string,
+	time time.Time,
+	current bool,
+) *BranchNamingViolation {
+	violation := &BranchNamingViolation{
+		violation: violation{
+			name:     "BranchNamingViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		branch: branch,
+		role:   role,
+		rules:  rules,
+		issue:  issue,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// BranchNamingViolation is violation when a branch name does not follow the naming rules of its role,
+// or references an issue that does not exist.
+type BranchNamingViolation struct {
+	violation
+	*display
+	branch markup.Branch
+	role   string
+	rules  []string
+	issue  int // Issue number referenced by the name that does not exist, zero when the name did not match.
+}
+
+// Message implements Violation.
+func (bnv *BranchNamingViolation) Message() string {
+	if bnv.issue != 0 {
+		return fmt.Sprintf("Branch %s references issue #%d, which does not exist", bnv.branch.Markdown(), bnv.issue)
+	}
+
+	rules := make([]string, len(bnv.rules))
+	for i, r := range bnv.rules {
+		rules[i] = markup.InlineCode(r)
+	}
+
+	return fmt.Sprintf("Branch %s does not follow the naming rules of %s branches: %s",
+		bnv.branch.Markdown(), bnv.role, strings.Join(rules, ", "))
+}
+
+// Suggestion implements Violation.
+func (bnv *BranchNamingViolation) Suggestion() (string, error) {
+	if bnv.issue != 0 {
+		return "Reference the number of the issue the branch works on, " +
+			"or create the issue before naming the branch after it", nil
+	}
+
+	return fmt.Sprintf("Rename the branch to follow the naming rules with \"git branch -m %s <name>\", "+
+		"then push the new name and delete the old branch from the remote", bnv.branch.Name), nil
+}
@@ -0,0 +1,77 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+// maxExampleFiles is the number of files listed by violations grouping many files.
+const maxExampleFiles = 3
+
+func NewBuildArtifactViolation(
+	commit markup.Commit,
+	pack string,
+	pattern string,
+	files []string,
+	email string,
+	time time.Time,
+	current bool,
+) *BuildArtifactViolation {
+	violation := &BuildArtifactViolation{
+		violation: violation{
+			name:     "BuildArtifactViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		commit:  commit,
+		pack:    pack,
+		pattern: pattern,
+		files:   files,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// BuildArtifactViolation is violation when a commit added build artifacts, dependencies or editor files.
+type BuildArtifactViolation struct {
+	violation
+	*display
+	commit  markup.Commit
+	pack    string // Ecosystem of the pattern, eg: "node".
+	pattern string
+	files   []string
+}
+
+// Message implements Violation.
+func (bav *BuildArtifactViolation) Message() string {
+	return fmt.Sprintf("Commit %s added %d %s files matching %s, eg: %s", bav.commit.Markdown(), len(bav.files),
+		bav.pack, markup.InlineCode(bav.pattern), exampleFiles(bav.files))
+}
+
+// Suggestion implements Violation.
+func (bav *BuildArtifactViolation) Suggestion() (string, error) {
+	return fmt.Sprintf("Build output, dependencies and editor files are generated on each machine. "+
+		"Add \"%s\" to the project .gitignore file and remove the files using \"git rm -r --cached\"",
+		bav.pattern), nil
+}
+
+// exampleFiles lists the first files as inline code.
+func exampleFiles(files []string) string {
+	examples := make([]string, 0, maxExampleFiles)
+	for i, f := range files {
+		if i == maxExampleFiles {
+			examples = append(examples, "...")
+
+			break
+		}
+		examples = append(examples, markup.InlineCode(f))
+	}
+
+	return strings.Join(examples, ", ")
+}
@@ -0,0 +1,69 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewCommitIssueReferenceViolation(
+	commit markup.Commit,
+	issue markup.Issue,
+	closedAt *time.Time,
+	email string,
+	time time.Time,
+	current bool,
+) *CommitIssueReferenceViolation {
+	severity := Violated
+	if closedAt != nil {
+		severity = Suggestion
+	}
+
+	violation := &CommitIssueReferenceViolation{
+		violation: violation{
+			name:     "CommitIssueReferenceViolation",
+			email:    email,
+			time:     time,
+			severity: severity,
+			current:  current,
+		},
+		commit:   commit,
+		issue:    issue,
+		closedAt: closedAt,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// CommitIssueReferenceViolation is violation when a commit message references an issue that does not exist,
+// or was already closed when the commit was made.
+type CommitIssueReferenceViolation struct {
+	violation
+	*display
+	commit   markup.Commit
+	issue    markup.Issue
+	closedAt *time.Time // Nil when the issue does not exist.
+}
+
+// Message implements Violation.
+func (cirv *CommitIssueReferenceViolation) Message() string {
+	if cirv.closedAt == nil {
+		return fmt.Sprintf("Commit %s references issue %s, which does not exist",
+			cirv.commit.Markdown(), cirv.issue.String())
+	}
+
+	return fmt.Sprintf("Commit %s references issue %s, which was closed on %s before the commit was made",
+		cirv.commit.Markdown(), cirv.issue.Markdown(), cirv.closedAt.Format("2006-01-02"))
+}
+
+// Suggestion implements Violation.
+func (cirv *CommitIssueReferenceViolation) Suggestion() (string, error) {
+	if cirv.closedAt == nil {
+		return "Check the issue number in the commit message, " +
+			"references to issues of other repositories are written as owner/repo#123", nil
+	}
+
+	return "Reopen the issue if the commit continues its work, or open a new issue for the follow up work", nil
+}
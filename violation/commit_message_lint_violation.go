@@ -0,0 +1,62 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewCommitMessageLintViolation(
+	commit markup.Commit,
+	message string,
+	rule string,
+	problem string,
+	suggestion string,
+	email string,
+	time time.Time,
+	current bool,
+) *CommitMessageLintViolation {
+	violation := &CommitMessageLintViolation{
+		violation: violation{
+			name:     "CommitMessageLintViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		commit:     commit,
+		message:    message,
+		rule:       rule,
+		problem:    problem,
+		suggestion: suggestion,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// CommitMessageLintViolation is violation when a commit message breaks a style rule.
+type CommitMessageLintViolation struct {
+	violation
+	*display
+	commit     markup.Commit
+	message    string
+	rule       string
+	problem    string
+	suggestion string
+}
+
+// Message implements Violation.
+func (cml *CommitMessageLintViolation) Message() string {
+	subject := strings.SplitN(cml.message, "\n", 2)[0]
+
+	return fmt.Sprintf("Commit message \"%s\" on %s breaks the %s rule: %s",
+		subject, cml.commit.Markdown(), cml.rule, cml.problem)
+}
+
+// Suggestion implements Violation.
+func (cml *CommitMessageLintViolation) Suggestion() (string, error) {
+	return cml.suggestion, nil
+}
@@ -0,0 +1,86 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewCommitTimestampViolation(
+	commit markup.Commit,
+	anomaly string,
+	author time.Time,
+	committer time.Time,
+	reference time.Time,
+	severity Severity,
+	email string,
+	current bool,
+) *CommitTimestampViolation {
+	violation := &CommitTimestampViolation{
+		violation: violation{
+			name:     "CommitTimestampViolation",
+			email:    email,
+			time:     committer,
+			severity: severity,
+			current:  current,
+		},
+		commit:    commit,
+		anomaly:   anomaly,
+		author:    author,
+		committer: committer,
+		reference: reference,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// CommitTimestampViolation is violation when the timestamps of a commit can not be trusted,
+// eg: dated in the future, before the repository or before its parents.
+// The time of the violation is the committer date, which is kept by date filters as it may be forged.
+type CommitTimestampViolation struct {
+	violation
+	*display
+	commit    markup.Commit
+	anomaly   string // Kind of anomaly, eg: "future" or "out-of-order".
+	author    time.Time
+	committer time.Time
+	reference time.Time
+}
+
+// Message implements Violation.
+func (ctv *CommitTimestampViolation) Message() string {
+	const layout = "2006-01-02 15:04 MST"
+
+	switch ctv.anomaly {
+	case "future":
+		return fmt.Sprintf("Commit %s is dated in the future, authored %s and committed %s",
+			ctv.commit.Markdown(), ctv.author.Format(layout), ctv.committer.Format(layout))
+	case "predates-repository":
+		return fmt.Sprintf("Commit %s was authored %s, before the first commit of the repository on %s",
+			ctv.commit.Markdown(), ctv.author.Format(layout), ctv.reference.Format(layout))
+	case "out-of-order":
+		return fmt.Sprintf("Commit %s was committed %s, before its parent was committed on %s",
+			ctv.commit.Markdown(), ctv.committer.Format(layout), ctv.reference.Format(layout))
+	case "backdated":
+		return fmt.Sprintf("Commit %s was authored %s, before the cutoff on %s, but committed after it on %s",
+			ctv.commit.Markdown(), ctv.author.Format(layout), ctv.reference.Format(layout),
+			ctv.committer.Format(layout))
+	default:
+		return fmt.Sprintf("Commit %s was authored %s but committed %d days later on %s",
+			ctv.commit.Markdown(), ctv.author.Format(layout),
+			int(ctv.committer.Sub(ctv.author).Hours()/24), ctv.committer.Format(layout))
+	}
+}
+
+// Suggestion implements Violation.
+func (ctv *CommitTimestampViolation) Suggestion() (string, error) {
+	if ctv.anomaly == "date-gap" {
+		return "Author dates long before the commit date come from rebasing or amending old commits, " +
+			"or from backdating with \"git commit --date\", check the commit was not backdated", nil
+	}
+
+	return "Keep the system clock synchronized and do not set GIT_AUTHOR_DATE, GIT_COMMITTER_DATE " +
+		"or \"git commit --date\", commit timestamps are used to decide when work was done", nil
+}
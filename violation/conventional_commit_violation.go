@@ -0,0 +1,62 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewConventionalCommitViolation(
+	commit markup.Commit,
+	message string,
+	reason string,
+	types []string,
+	email string,
+	time time.Time,
+	current bool,
+) *ConventionalCommitViolation {
+	violation := &ConventionalCommitViolation{
+		violation: violation{
+			name:     "ConventionalCommitViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		commit:  commit,
+		message: message,
+		reason:  reason,
+		types:   types,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// ConventionalCommitViolation is violation when a commit on the primary branch
+// does not follow the Conventional Commits specification.
+type ConventionalCommitViolation struct {
+	violation
+	*display
+	commit  markup.Commit
+	message string
+	reason  string
+	types   []string
+}
+
+// Message implements Violation.
+func (ccv *ConventionalCommitViolation) Message() string {
+	subject := strings.SplitN(ccv.message, "\n", 2)[0]
+
+	return fmt.Sprintf("Commit message \"%s\" on %s is not a conventional commit: %s",
+		subject, ccv.commit.Markdown(), ccv.reason)
+}
+
+// Suggestion implements Violation.
+func (ccv *ConventionalCommitViolation) Suggestion() (string, error) {
+	return fmt.Sprintf("Start the commit message with \"<type>(<scope>): <description>\" using one of the types %s, "+
+		"eg: \"feat(api): add pagination\". Breaking changes are marked with \"!\" before the colon "+
+		"or a \"BREAKING CHANGE:\" footer. See https://www.conventionalcommits.org", strings.Join(ccv.types, ", ")), nil
+}
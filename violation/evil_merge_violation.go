@@ -0,0 +1,86 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewEvilMergeViolation(
+	line markup.Line,
+	lines string,
+	added int,
+	removed int,
+	conflict bool,
+	email string,
+	time time.Time,
+	current bool,
+) *EvilMergeViolation {
+	severity := Violated
+	if conflict {
+		severity = Suggestion
+	}
+
+	violation := &EvilMergeViolation{
+		violation: violation{
+			name:     "EvilMergeViolation",
+			email:    email,
+			time:     time,
+			severity: severity,
+			current:  current,
+		},
+		line:     line,
+		lines:    lines,
+		added:    added,
+		removed:  removed,
+		conflict: conflict,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// EvilMergeViolation is violation when a merge commit adds or drops lines that are in neither parent, changes
+// hidden from reviewers of the merged branches. Conflict resolutions are suggested for review.
+type EvilMergeViolation struct {
+	violation
+	*display
+	line     markup.Line // First changed line of the file.
+	lines    string      // Changed lines of the file, eg: "2, 7-9".
+	added    int
+	removed  int
+	conflict bool
+}
+
+// Message implements Violation.
+func (emv *EvilMergeViolation) Message() string {
+	if emv.conflict {
+		return fmt.Sprintf("Merge resolves conflicts at %s, lines %s, adding %d and removing %d lines",
+			emv.line.Markdown(), emv.lines, emv.added, emv.removed)
+	}
+
+	return fmt.Sprintf("Merge adds %d and removes %d lines that are in neither parent at %s, lines %s",
+		emv.added, emv.removed, emv.line.Markdown(), emv.lines)
+}
+
+// Suggestion implements Violation.
+func (emv *EvilMergeViolation) Suggestion() (string, error) {
+	if emv.conflict {
+		return "Review the conflict resolution with \"git show --cc\" " +
+			"as the resolved lines were not part of either reviewed branch", nil
+	}
+
+	return "Make changes in a commit of their own instead of the merge commit, " +
+		"changes hidden in a merge are missed by reviewers and by \"git log -p\"", nil
+}
+
+// FileLocation implements Violation.
+func (emv *EvilMergeViolation) FileLocation() (string, error) {
+	return emv.line.File.Filepath, nil
+}
+
+// LineLocation implements Violation.
+func (emv *EvilMergeViolation) LineLocation() (int, error) {
+	return emv.line.Start, nil
+}
@@ -0,0 +1,65 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewFixupCommitViolation(
+	commit markup.Commit,
+	message string,
+	marker string,
+	pr *markup.PR,
+	email string,
+	time time.Time,
+	current bool,
+) *FixupCommitViolation {
+	violation := &FixupCommitViolation{
+		violation: violation{
+			name:     "FixupCommitViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		commit:  commit,
+		message: message,
+		marker:  marker,
+		pr:      pr,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// FixupCommitViolation is violation when a fixup, squash or work in progress commit reaches the primary branch.
+type FixupCommitViolation struct {
+	violation
+	*display
+	commit  markup.Commit
+	message string
+	marker  string
+	pr      *markup.PR // Pull request that merged the commit, nil when committed directly.
+}
+
+// Message implements Violation.
+func (fcv *FixupCommitViolation) Message() string {
+	subject := strings.SplitN(fcv.message, "\n", 2)[0]
+	if fcv.pr != nil {
+		return fmt.Sprintf("Commit \"%s\" on %s marked \"%s\" was merged by %s without cleaning the history",
+			subject, fcv.commit.Markdown(), fcv.marker, fcv.pr.Markdown())
+	}
+
+	return fmt.Sprintf("Commit \"%s\" on %s marked \"%s\" was committed to the primary branch",
+		subject, fcv.commit.Markdown(), fcv.marker)
+}
+
+// Suggestion implements Violation.
+func (fcv *FixupCommitViolation) Suggestion() (string, error) {
+	return "Clean up fixup and work in progress commits before merging, " +
+		"eg: \"git rebase -i --autosquash <base>\" folds fixup! and squash! commits into the commits they fix, " +
+		"or squash merge the pull request", nil
+}
@@ -0,0 +1,69 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewFormattingCommitViolation(
+	commit markup.Commit,
+	kind string,
+	files int,
+	email string,
+	time time.Time,
+	current bool,
+) *FormattingCommitViolation {
+	violation := &FormattingCommitViolation{
+		violation: violation{
+			name:     "FormattingCommitViolation",
+			email:    email,
+			time:     time,
+			severity: Suggestion,
+			current:  current,
+		},
+		commit: commit,
+		kind:   kind,
+		files:  files,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// FormattingCommitViolation is a suggestion when a commit only changes formatting, eg: line endings or file modes.
+// Formatting commits are not counted as contributions.
+type FormattingCommitViolation struct {
+	violation
+	*display
+	commit markup.Commit
+	kind   string // Kind of formatting change, eg: "line-ending".
+	files  int
+}
+
+// Message implements Violation.
+func (fcv *FormattingCommitViolation) Message() string {
+	changes := map[string]string{
+		"mode":        "file modes",
+		"line-ending": "line endings",
+		"whitespace":  "whitespace",
+		"reformat":    "formatting",
+	}
+
+	return fmt.Sprintf("Commit %s only changes the %s of %d files", fcv.commit.Markdown(), changes[fcv.kind], fcv.files)
+}
+
+// Suggestion implements Violation.
+func (fcv *FormattingCommitViolation) Suggestion() (string, error) {
+	switch fcv.kind {
+	case "mode":
+		return "Set \"git config core.fileMode false\" if the file modes were changed by accident, " +
+			"eg: by copying files from Windows", nil
+	case "line-ending":
+		return "Normalise line endings with \"* text=auto\" in .gitattributes so editors can not convert them", nil
+	default:
+		return "Add the commit to .git-blame-ignore-revs so it is skipped by git blame, " +
+			"and run the formatter in CI so formatting does not churn", nil
+	}
+}
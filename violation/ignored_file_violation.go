@@ -0,0 +1,52 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewIgnoredFileViolation(
+	commit markup.Commit,
+	files []string,
+	email string,
+	time time.Time,
+	current bool,
+) *IgnoredFileViolation {
+	violation := &IgnoredFileViolation{
+		violation: violation{
+			name:     "IgnoredFileViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		commit: commit,
+		files:  files,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// IgnoredFileViolation is violation when a commit added files that are still committed but matched by the
+// repository's own .gitignore, eg: the .gitignore was added after the files.
+type IgnoredFileViolation struct {
+	violation
+	*display
+	commit markup.Commit
+	files  []string
+}
+
+// Message implements Violation.
+func (ifv *IgnoredFileViolation) Message() string {
+	return fmt.Sprintf("Commit %s added %d files that are ignored by .gitignore but still committed, eg: %s",
+		ifv.commit.Markdown(), len(ifv.files), exampleFiles(ifv.files))
+}
+
+// Suggestion implements Violation.
+func (ifv *IgnoredFileViolation) Suggestion() (string, error) {
+	return "Ignoring a file does not remove it once committed. " +
+		"Stop tracking the files using \"git rm --cached <file>\" so the .gitignore applies to them", nil
+}
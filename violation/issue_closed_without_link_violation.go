@@ -0,0 +1,48 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewIssueClosedWithoutLinkViolation(
+	issue markup.Issue,
+	login string,
+	time time.Time,
+	current bool,
+) *IssueClosedWithoutLinkViolation {
+	violation := &IssueClosedWithoutLinkViolation{
+		violation: violation{
+			name:     "IssueClosedWithoutLinkViolation",
+			login:    login,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		issue: issue,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// IssueClosedWithoutLinkViolation is violation when an issue is closed as completed without a commit or pull request
+// that resolved it.
+type IssueClosedWithoutLinkViolation struct {
+	violation
+	*display
+	issue markup.Issue
+}
+
+// Message implements Violation.
+func (icv *IssueClosedWithoutLinkViolation) Message() string {
+	return fmt.Sprintf("Issue %s was closed without a linked pull request or commit", icv.issue.Markdown())
+}
+
+// Suggestion implements Violation.
+func (icv *IssueClosedWithoutLinkViolation) Suggestion() (string, error) {
+	return fmt.Sprintf("Close issues from the pull request that resolves them with \"Closes %s\" in its description, "+
+		"or close the issue as not planned when no work was done", icv.issue.String()), nil
+}
@@ -0,0 +1,60 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewIssueTriageViolation(
+	issue markup.Issue,
+	unlabeled bool,
+	unassigned bool,
+	login string,
+	time time.Time,
+	current bool,
+) *IssueTriageViolation {
+	violation := &IssueTriageViolation{
+		violation: violation{
+			name:     "IssueTriageViolation",
+			login:    login,
+			time:     time,
+			severity: Suggestion,
+			current:  current,
+		},
+		issue:      issue,
+		unlabeled:  unlabeled,
+		unassigned: unassigned,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// IssueTriageViolation is violation when an issue has no labels or assignees.
+type IssueTriageViolation struct {
+	violation
+	*display
+	issue      markup.Issue
+	unlabeled  bool
+	unassigned bool
+}
+
+// Message implements Violation.
+func (itv *IssueTriageViolation) Message() string {
+	switch {
+	case itv.unlabeled && itv.unassigned:
+		return fmt.Sprintf("Issue %s has no labels or assignees", itv.issue.Markdown())
+	case itv.unlabeled:
+		return fmt.Sprintf("Issue %s has no labels", itv.issue.Markdown())
+	default:
+		return fmt.Sprintf("Issue %s has no assignees", itv.issue.Markdown())
+	}
+}
+
+// Suggestion implements Violation.
+func (itv *IssueTriageViolation) Suggestion() (string, error) {
+	return "Label issues by their type, eg: bug or enhancement, and assign them to who is working on them " +
+		"so the team can see what is planned and who is responsible", nil
+}
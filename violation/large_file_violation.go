@@ -0,0 +1,99 @@
+package violation
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewLargeFileViolation(
+	file markup.File,
+	size int64,
+	maxSize int64,
+	binary bool,
+	lfsTracked bool,
+	email string,
+	time time.Time,
+	current bool,
+) *LargeFileViolation {
+	violation := &LargeFileViolation{
+		violation: violation{
+			name:     "LargeFileViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		file:       file,
+		size:       size,
+		maxSize:    maxSize,
+		binary:     binary,
+		lfsTracked: lfsTracked,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// LargeFileViolation is violation when a file larger than the size limit has been committed without Git LFS.
+type LargeFileViolation struct {
+	violation
+	*display
+	file       markup.File
+	size       int64
+	maxSize    int64
+	binary     bool
+	lfsTracked bool // File matches a Git LFS pattern but was committed as a regular blob.
+}
+
+// Message implements Violation.
+func (lfv *LargeFileViolation) Message() string {
+	return fmt.Sprintf("A %s file %s is larger than %s", formatSize(lfv.size), lfv.file.Markdown(),
+		formatSize(lfv.maxSize))
+}
+
+// Suggestion implements Violation.
+func (lfv *LargeFileViolation) Suggestion() (string, error) {
+	switch {
+	case lfv.lfsTracked:
+		return fmt.Sprintf("The file is tracked by Git LFS in .gitattributes but was committed without LFS. "+
+			"Run \"git lfs install\" and \"git lfs migrate import --include=\\\"%s\\\"\" to move it to LFS",
+			lfv.file.Filepath), nil
+	case lfv.binary:
+		pattern := "*" + path.Ext(lfv.file.Filepath)
+		if pattern == "*" {
+			pattern = lfv.file.Filepath
+		}
+
+		return fmt.Sprintf("Every version of a large binary stays in the history and slows down cloning. "+
+			"Track large binaries with Git LFS using \"git lfs track \\\"%s\\\"\" and commit the .gitattributes file",
+			pattern), nil
+	default:
+		return "Large text files are often generated such as build output, logs or datasets. " +
+			"Add generated files to the project .gitignore file and remove them from the working tree using " +
+			"\"git rm --cached <file>\"", nil
+	}
+}
+
+// FileLocation implements Violation.
+func (lfv *LargeFileViolation) FileLocation() (string, error) {
+	return lfv.file.Filepath, nil
+}
+
+// formatSize formats bytes with a binary unit, eg: 1.5 MB.
+func formatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,55 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewMergeStrategyViolation(
+	pr markup.PR,
+	strategy string,
+	expected string,
+	login string,
+	time time.Time,
+	current bool,
+) *MergeStrategyViolation {
+	violation := &MergeStrategyViolation{
+		violation: violation{
+			name:     "MergeStrategyViolation",
+			login:    login,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		pr:       pr,
+		strategy: strategy,
+		expected: expected,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// MergeStrategyViolation is violation when a pull request is merged with a different strategy than expected.
+type MergeStrategyViolation struct {
+	violation
+	*display
+	pr       markup.PR
+	strategy string
+	expected string
+}
+
+// Message implements Violation.
+func (msv *MergeStrategyViolation) Message() string {
+	format := "Pull request %s was merged using %s instead of %s"
+
+	return fmt.Sprintf(format, msv.pr.Markdown(), msv.strategy, msv.expected)
+}
+
+// Suggestion implements Violation.
+func (msv *MergeStrategyViolation) Suggestion() (string, error) {
+	return fmt.Sprintf("Merge pull requests using the %s strategy agreed on by the team. "+
+		"A consistent merge strategy keeps the history of the primary branch predictable", msv.expected), nil
+}
@@ -0,0 +1,58 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewMergedBranchViolation(
+	branch markup.Branch,
+	base string,
+	pr *markup.PR,
+	email string,
+	time time.Time,
+	current bool,
+) *MergedBranchViolation {
+	violation := &MergedBranchViolation{
+		violation: violation{
+			name:     "MergedBranchViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		branch: branch,
+		base:   base,
+		pr:     pr,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// MergedBranchViolation is violation when a branch was merged but not deleted.
+type MergedBranchViolation struct {
+	violation
+	*display
+	branch markup.Branch
+	base   string
+	pr     *markup.PR // Pull request that merged the branch, nil when merged without one.
+}
+
+// Message implements Violation.
+func (mbv *MergedBranchViolation) Message() string {
+	if mbv.pr != nil {
+		return fmt.Sprintf("Branch %s was merged into \"%s\" by %s but not deleted",
+			mbv.branch.Markdown(), mbv.base, mbv.pr.Markdown())
+	}
+
+	return fmt.Sprintf("Branch %s was merged into \"%s\" but not deleted", mbv.branch.Markdown(), mbv.base)
+}
+
+// Suggestion implements Violation.
+func (mbv *MergedBranchViolation) Suggestion() (string, error) {
+	return fmt.Sprintf("Delete the branch with \"git push origin --delete %s\", "+
+		"and enable automatically deleting head branches in the repository settings", mbv.branch.Name), nil
+}
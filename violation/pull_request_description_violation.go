@@ -0,0 +1,61 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewPullRequestDescriptionViolation(
+	pr markup.PR,
+	problems []string,
+	template bool,
+	current bool,
+	time time.Time,
+	login string,
+) *PullRequestDescriptionViolation {
+	violation := &PullRequestDescriptionViolation{
+		violation: violation{
+			name:     "PullRequestDescriptionViolation",
+			severity: Violated,
+			time:     time,
+			login:    login,
+			current:  current,
+		},
+		pr:       pr,
+		problems: problems,
+		template: template,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// PullRequestDescriptionViolation is violation when a pull request description is empty or does not follow the
+// pull request template.
+type PullRequestDescriptionViolation struct {
+	violation
+	*display
+	pr       markup.PR
+	problems []string
+	template bool // The repository has a pull request template.
+}
+
+// Message implements Violation.
+func (pdv *PullRequestDescriptionViolation) Message() string {
+	return fmt.Sprintf("Pull request at %s has a poor description: %s",
+		pdv.pr.Markdown(), strings.Join(pdv.problems, ", "))
+}
+
+// Suggestion implements Violation.
+func (pdv *PullRequestDescriptionViolation) Suggestion() (string, error) {
+	if pdv.template {
+		return "Fill in every section of the pull request template and check the checklist items that apply, " +
+			"reviewers rely on the description to understand what changed and why", nil
+	}
+
+	return "Describe what the pull request changes and why, and how it was tested. " +
+		"A pull request template in .github/pull_request_template.md prompts authors for these", nil
+}
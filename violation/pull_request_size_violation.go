@@ -0,0 +1,61 @@
+package violation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewPullRequestSizeViolation(
+	pr markup.PR,
+	lines int,
+	files int,
+	commits int,
+	exceeded []string,
+	current bool,
+	time time.Time,
+	login string,
+) *PullRequestSizeViolation {
+	violation := &PullRequestSizeViolation{
+		violation: violation{
+			name:     "PullRequestSizeViolation",
+			severity: Violated,
+			time:     time,
+			login:    login,
+			current:  current,
+		},
+		pr:       pr,
+		lines:    lines,
+		files:    files,
+		commits:  commits,
+		exceeded: exceeded,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// PullRequestSizeViolation is violation when a pull request is too large to review.
+type PullRequestSizeViolation struct {
+	violation
+	*display
+	pr       markup.PR
+	lines    int
+	files    int
+	commits  int
+	exceeded []string // Limits that were exceeded, eg: "400 lines".
+}
+
+// Message implements Violation.
+func (psv *PullRequestSizeViolation) Message() string {
+	return fmt.Sprintf("Pull request at %s changes %d lines in %d files over %d commits, more than %s",
+		psv.pr.Markdown(), psv.lines, psv.files, psv.commits, strings.Join(psv.exceeded, " and "))
+}
+
+// Suggestion implements Violation.
+func (psv *PullRequestSizeViolation) Suggestion() (string, error) {
+	return "Large pull requests are hard to review thoroughly. Split the change into smaller pull requests " +
+		"that can each be reviewed and merged on their own, eg: refactors before the feature that needs them", nil
+}
@@ -0,0 +1,49 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+)
+
+func NewRepositoryBloatViolation(
+	size int64,
+	files int,
+	email string,
+	time time.Time,
+	current bool,
+) *RepositoryBloatViolation {
+	violation := &RepositoryBloatViolation{
+		violation: violation{
+			name:     "RepositoryBloatViolation",
+			email:    email,
+			time:     time,
+			severity: Suggestion,
+			current:  current,
+		},
+		size:  size,
+		files: files,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// RepositoryBloatViolation reports the total size of large files an author has added to the history.
+type RepositoryBloatViolation struct {
+	violation
+	*display
+	size  int64
+	files int
+}
+
+// Message implements Violation.
+func (rbv *RepositoryBloatViolation) Message() string {
+	return fmt.Sprintf("%s of large files were added to the history across %d file versions",
+		formatSize(rbv.size), rbv.files)
+}
+
+// Suggestion implements Violation.
+func (rbv *RepositoryBloatViolation) Suggestion() (string, error) {
+	return "Files stay in the history after they are deleted, so every clone downloads them. " +
+		"Use Git LFS for large assets and keep generated files out of the repository", nil
+}
@@ -0,0 +1,57 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewRubberStampViolation(
+	pr markup.PR,
+	lines int,
+	reviewTime time.Duration,
+	reviewer string,
+	current bool,
+	time time.Time,
+	login string,
+) *RubberStampViolation {
+	violation := &RubberStampViolation{
+		violation: violation{
+			name:     "RubberStampViolation",
+			severity: Violated,
+			time:     time,
+			login:    login,
+			current:  current,
+		},
+		pr:         pr,
+		lines:      lines,
+		reviewTime: reviewTime,
+		reviewer:   reviewer,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// RubberStampViolation is violation when a pull request is approved too quickly for its size to have been reviewed.
+type RubberStampViolation struct {
+	violation
+	*display
+	pr         markup.PR
+	lines      int
+	reviewTime time.Duration
+	reviewer   string
+}
+
+// Message implements Violation.
+func (rsv *RubberStampViolation) Message() string {
+	return fmt.Sprintf("Pull request at %s changing %d lines was approved by @%s %s after it was opened",
+		rsv.pr.Markdown(), rsv.lines, rsv.reviewer, rsv.reviewTime.Round(time.Second))
+}
+
+// Suggestion implements Violation.
+func (rsv *RubberStampViolation) Suggestion() (string, error) {
+	return "Take the time to read the changes before approving, an approval should mean the code was reviewed. " +
+		"Ask the author to split pull requests that are too large to review in one sitting", nil
+}
@@ -0,0 +1,76 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewSecretViolation(
+	line markup.Line,
+	rule string,
+	secret string,
+	deletedIn *markup.Commit,
+	email string,
+	time time.Time,
+	current bool,
+) *SecretViolation {
+	violation := &SecretViolation{
+		violation: violation{
+			name:     "SecretViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		line:      line,
+		rule:      rule,
+		secret:    secret,
+		deletedIn: deletedIn,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// SecretViolation is violation when a secret such as an API key has been committed to the repository.
+type SecretViolation struct {
+	violation
+	*display
+	line      markup.Line
+	rule      string
+	secret    string // Redacted secret.
+	deletedIn *markup.Commit
+}
+
+// Message implements Violation.
+func (sv *SecretViolation) Message() string {
+	if sv.deletedIn != nil {
+		return fmt.Sprintf("%s \"%s\" committed at %s was deleted in %s but remains in the history",
+			sv.rule, sv.secret, sv.line.Markdown(), sv.deletedIn.Markdown())
+	}
+
+	return fmt.Sprintf("%s \"%s\" has been committed at %s", sv.rule, sv.secret, sv.line.Markdown())
+}
+
+// Suggestion implements Violation.
+func (sv *SecretViolation) Suggestion() (string, error) {
+	return "Revoke and rotate the secret as anyone with access to the history can read it, " +
+		"deleting it in a later commit does not remove it. Load secrets from the environment or a secret store " +
+		"and rewrite the history with \"git filter-repo\" if the secret must be removed", nil
+}
+
+// FileLocation implements Violation.
+func (sv *SecretViolation) FileLocation() (string, error) {
+	return sv.line.File.Filepath, nil
+}
+
+// LineLocation implements Violation.
+func (sv *SecretViolation) LineLocation() (int, error) {
+	if sv.line.Start == 0 {
+		return 0, ErrViolationMethodNotExist
+	}
+
+	return sv.line.Start, nil
+}
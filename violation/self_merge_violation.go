@@ -0,0 +1,48 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewSelfMergeViolation(
+	pr markup.PR,
+	current bool,
+	time time.Time,
+	login string,
+) *SelfMergeViolation {
+	violation := &SelfMergeViolation{
+		violation: violation{
+			name:     "SelfMergeViolation",
+			severity: Violated,
+			time:     time,
+			login:    login,
+			current:  current,
+		},
+		pr: pr,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// SelfMergeViolation is violation when the author merges their own pull request without another approver.
+type SelfMergeViolation struct {
+	violation
+	*display
+	pr markup.PR
+}
+
+// Message implements Violation.
+func (smv *SelfMergeViolation) Message() string {
+	return fmt.Sprintf("Pull request at %s was merged by @%s, its author, without approval from another reviewer",
+		smv.pr.Markdown(), smv.login)
+}
+
+// Suggestion implements Violation.
+func (smv *SelfMergeViolation) Suggestion() (string, error) {
+	return "Request a review from a teammate and wait for their approval before merging your own pull request. " +
+		"Branch protection rules can require an approval before merging", nil
+}
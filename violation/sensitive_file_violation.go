@@ -0,0 +1,60 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewSensitiveFileViolation(
+	file markup.File,
+	deletedIn *markup.Commit,
+	email string,
+	time time.Time,
+	current bool,
+) *SensitiveFileViolation {
+	violation := &SensitiveFileViolation{
+		violation: violation{
+			name:     "SensitiveFileViolation",
+			email:    email,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		file:      file,
+		deletedIn: deletedIn,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// SensitiveFileViolation is violation when a file that usually contains secrets, eg: .env, has been committed.
+type SensitiveFileViolation struct {
+	violation
+	*display
+	file      markup.File
+	deletedIn *markup.Commit
+}
+
+// Message implements Violation.
+func (sfv *SensitiveFileViolation) Message() string {
+	if sfv.deletedIn != nil {
+		return fmt.Sprintf("A sensitive file %s was deleted in %s but remains in the history",
+			sfv.file.Markdown(), sfv.deletedIn.Markdown())
+	}
+
+	return fmt.Sprintf("A sensitive file %s has been committed to the project", sfv.file.Markdown())
+}
+
+// Suggestion implements Violation.
+func (sfv *SensitiveFileViolation) Suggestion() (string, error) {
+	return "Files such as .env and private keys should be added to the project .gitignore file, " +
+		"commit a template such as .env.example instead. Rotate any secrets the file contained", nil
+}
+
+// FileLocation implements Violation.
+func (sfv *SensitiveFileViolation) FileLocation() (string, error) {
+	return sfv.file.Filepath, nil
+}
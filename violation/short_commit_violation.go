@@ -11,6 +11,7 @@ import (
 func NewShortCommitViolation(
 	commit markup.Commit,
 	message string,
+	minWords int,
 	email string,
 	time time.Time,
 	current bool,
@@ -23,8 +24,9 @@ func NewShortCommitViolation(
 			severity: Violated,
 			current:  current,
 		},
-		commit:  commit,
-		message: message,
+		commit:   commit,
+		message:  message,
+		minWords: minWords,
 	}
 	violation.display = &display{violation}
 
@@ -35,8 +37,9 @@ func NewShortCommitViolation(
 type ShortCommitViolation struct {
 	violation
 	*display
-	commit  markup.Commit
-	message string
+	commit   markup.Commit
+	message  string
+	minWords int
 }
 
 // Message implements Violation.
@@ -48,6 +51,7 @@ func (sc *ShortCommitViolation) Message() string {
 
 // Suggestion implements Violation.
 func (scv *ShortCommitViolation) Suggestion() (string, error) {
-	return "Try to make your commit messages longer than 5 words " +
-		"so that your peers can accurately know the changes a commit contains without manually examining it", nil
+	return fmt.Sprintf("Try to make your commit messages at least %d words long "+
+		"so that your peers can accurately know the changes a commit contains without manually examining it",
+		scv.minWords), nil
 }
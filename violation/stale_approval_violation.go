@@ -0,0 +1,54 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewStaleApprovalViolation(
+	pr markup.PR,
+	approved markup.Commit,
+	reviewer string,
+	current bool,
+	time time.Time,
+	login string,
+) *StaleApprovalViolation {
+	violation := &StaleApprovalViolation{
+		violation: violation{
+			name:     "StaleApprovalViolation",
+			severity: Violated,
+			time:     time,
+			login:    login,
+			current:  current,
+		},
+		pr:       pr,
+		approved: approved,
+		reviewer: reviewer,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// StaleApprovalViolation is violation when a pull request is merged with changes pushed after its last approval.
+type StaleApprovalViolation struct {
+	violation
+	*display
+	pr       markup.PR
+	approved markup.Commit // Head commit when the pull request was last approved.
+	reviewer string
+}
+
+// Message implements Violation.
+func (sav *StaleApprovalViolation) Message() string {
+	return fmt.Sprintf("Pull request at %s was last approved by @%s at %s, changes pushed after the approval "+
+		"were merged without review", sav.pr.Markdown(), sav.reviewer, sav.approved.Markdown())
+}
+
+// Suggestion implements Violation.
+func (sav *StaleApprovalViolation) Suggestion() (string, error) {
+	return "Request another review after pushing changes to an approved pull request. " +
+		"Branch protection can dismiss stale approvals when new commits are pushed", nil
+}
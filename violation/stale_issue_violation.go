@@ -0,0 +1,50 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewStaleIssueViolation(
+	issue markup.Issue,
+	inactive time.Duration,
+	login string,
+	time time.Time,
+	current bool,
+) *StaleIssueViolation {
+	violation := &StaleIssueViolation{
+		violation: violation{
+			name:     "StaleIssueViolation",
+			login:    login,
+			time:     time,
+			severity: Violated,
+			current:  current,
+		},
+		issue:    issue,
+		inactive: inactive,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// StaleIssueViolation is violation when an open issue has had no activity for a long time.
+type StaleIssueViolation struct {
+	violation
+	*display
+	issue    markup.Issue
+	inactive time.Duration
+}
+
+// Message implements Violation.
+func (siv *StaleIssueViolation) Message() string {
+	return fmt.Sprintf("Issue %s has had no activity for %d days", siv.issue.Markdown(), int(siv.inactive.Hours()/24))
+}
+
+// Suggestion implements Violation.
+func (siv *StaleIssueViolation) Suggestion() (string, error) {
+	return "Close the issue if it was resolved or is no longer planned, " +
+		"otherwise comment on its progress or assign it to someone who can pick it up", nil
+}
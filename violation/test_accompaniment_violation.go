@@ -0,0 +1,64 @@
+package violation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+)
+
+func NewTestAccompanimentViolation(
+	pr *markup.PR,
+	tested int,
+	total int,
+	minRatio float64,
+	email string,
+	time time.Time,
+	current bool,
+) *TestAccompanimentViolation {
+	violation := &TestAccompanimentViolation{
+		violation: violation{
+			name:     "TestAccompanimentViolation",
+			email:    email,
+			time:     time,
+			severity: Suggestion,
+			current:  current,
+		},
+		pr:       pr,
+		tested:   tested,
+		total:    total,
+		minRatio: minRatio,
+	}
+	violation.display = &display{violation}
+
+	return violation
+}
+
+// TestAccompanimentViolation is a suggestion when too few source changes of an author or pull request change the
+// tests of the source files with them.
+type TestAccompanimentViolation struct {
+	violation
+	*display
+	pr       *markup.PR // Pull request of the source files, nil for the commits of an author.
+	tested   int        // Commits of the author, or source files of the pull request, with test changes.
+	total    int
+	minRatio float64
+}
+
+// Message implements Violation.
+func (tav *TestAccompanimentViolation) Message() string {
+	ratio, minRatio := 100*tav.tested/tav.total, int(100*tav.minRatio)
+	if tav.pr != nil {
+		return fmt.Sprintf("Pull request at %s changes %d source files but only changes the tests of %d (%d%%), "+
+			"less than %d%%", tav.pr.Markdown(), tav.total, tav.tested, ratio, minRatio)
+	}
+
+	return fmt.Sprintf("Only %d of %d commits changing source files also change their tests (%d%%), less than %d%%",
+		tav.tested, tav.total, ratio, minRatio)
+}
+
+// Suggestion implements Violation.
+func (tav *TestAccompanimentViolation) Suggestion() (string, error) {
+	return "Change the tests of a source file with it, eg: parser_test.go with parser.go, " +
+		"so reviewers can see the change is tested", nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Git-Gopher/go-gopher/identity"
 	"github.com/Git-Gopher/go-gopher/markup"
-	"github.com/Git-Gopher/go-gopher/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -24,12 +24,12 @@ var (
 )
 
 type Violation interface {
-	Name() string                 // required: Internal name of the violation.
-	Message() string              // required: Warning message.
-	Display(utils.Authors) string // required: Formal display line of the violation.
-	Time() time.Time              // required: Time of the violation.
-	Severity() Severity           // required: Severity of the violation.
-	Current() bool                // required: Is the violation related to the current reporting
+	Name() string                     // required: Internal name of the violation.
+	Message() string                  // required: Warning message.
+	Display(identity.Resolver) string // required: Formal display line of the violation.
+	Time() time.Time                  // required: Time of the violation.
+	Severity() Severity               // required: Severity of the violation.
+	Current() bool                    // required: Is the violation related to the current reporting
 
 	Email() (string, error)        // optional Email address of the violator.
 	Login() (string, error)        // optional username of the violator.
@@ -44,16 +44,11 @@ type display struct {
 }
 
 // Display implements Violation.
-func (d *display) Display(authors utils.Authors) string {
+func (d *display) Display(identities identity.Resolver) string {
 	// Get the author of the violation.
 	authorLink := "unknown"
-	if login, err := d.v.Login(); err == nil {
+	if login, err := ResolveLogin(d.v, identities); err == nil {
 		authorLink = markup.Author(login).Markdown()
-	} else if email, err := d.v.Email(); err == nil {
-		login, err := authors.FindUserName(email)
-		if login != nil && err == nil {
-			authorLink = markup.Author(*login).Markdown()
-		}
 	}
 
 	suggestion, err := d.v.Suggestion()
@@ -132,7 +127,30 @@ func (v *violation) Current() bool {
 	return v.current
 }
 
-func FilterByLogin(violations []Violation, users utils.Authors, filter []string) []Violation {
+// ResolveLogin finds the login of the violator, resolving the email through the identities if required.
+func ResolveLogin(v Violation, identities identity.Resolver) (string, error) {
+	if login, err := v.Login(); err == nil {
+		return login, nil
+	}
+
+	email, err := v.Email()
+	if err != nil {
+		return "", err
+	}
+
+	if identities == nil {
+		return "", identity.ErrIdentityNotFound
+	}
+
+	login, err := identities.FindUserName(email)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve violation author: %w", err)
+	}
+
+	return *login, nil
+}
+
+func FilterByLogin(violations []Violation, identities identity.Resolver, filter []string) []Violation {
 	if len(filter) == 0 || len(violations) == 0 {
 		return violations
 	}
@@ -143,15 +161,9 @@ func FilterByLogin(violations []Violation, users utils.Authors, filter []string)
 
 	filtered := []Violation{}
 	for _, v := range violations {
-		login := ""
-		if l, err := v.Login(); err == nil {
-			login = l
-		} else if email, err := v.Email(); users != nil && err == nil {
-			if l, err := users.FindUserName(email); err == nil {
-				login = *l
-			}
-		} else {
-			// Violation does not have author.
+		login, err := ResolveLogin(v, identities)
+		if err != nil {
+			// Violation does not have a known author.
 			filtered = append(filtered, v)
 
 			continue
@@ -166,10 +178,11 @@ func FilterByLogin(violations []Violation, users utils.Authors, filter []string)
 }
 
 // Filter all logins occurring after a certain date.
+// Timestamp violations are always kept, as the dates they report can not be trusted.
 func FilterByDate(violations []Violation, cutoff time.Time) []Violation {
 	filtered := []Violation{}
 	for _, v := range violations {
-		if v.Time().Before(cutoff) {
+		if _, ok := v.(*CommitTimestampViolation); ok || v.Time().Before(cutoff) {
 			filtered = append(filtered, v)
 		} else {
 			log.Info("skipping violation")
@@ -0,0 +1,64 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+)
+
+// CleanupScript creates a shell script that deletes the merged branches from the remote. The script is a dry run
+// that lists the branches unless run with --execute. Branches are only deleted while their head is still the
+// analyzed commit, so branches that were pushed to since are kept.
+func CleanupScript(em *enriched.EnrichedModel) string {
+	var sb strings.Builder
+
+	sb.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&sb, "# Deletes the branches of %s/%s that were merged but not deleted.\n", em.Owner, em.Name)
+	sb.WriteString("# Dry run by default, run with --execute to delete the branches from $REMOTE (origin).\n")
+	sb.WriteString(`set -eu
+
+REMOTE="${REMOTE:-origin}"
+EXECUTE=0
+if [ "${1:-}" = "--execute" ]; then
+	EXECUTE=1
+fi
+
+# delete <branch> <head> <reason>
+delete() {
+	if [ "$EXECUTE" = 1 ]; then
+		git push "$REMOTE" --force-with-lease="refs/heads/$1:$2" ":refs/heads/$1"
+	else
+		echo "would delete $1 ($3)"
+	fi
+}
+
+`)
+
+	for _, mb := range em.MergedBranches() {
+		reason := fmt.Sprintf("merged into %s", mb.Base)
+		if mb.PullRequest != nil {
+			reason = fmt.Sprintf("merged into %s by #%d", mb.Base, mb.PullRequest.Number)
+		}
+
+		fmt.Fprintf(&sb, "delete %s %s %s\n", shellQuote(mb.Branch), mb.Head.HexString(), shellQuote(reason))
+	}
+
+	return sb.String()
+}
+
+// WriteCleanupScript writes the cleanup script of the merged branches to the file.
+func WriteCleanupScript(fn string, em *enriched.EnrichedModel) error {
+	if err := os.WriteFile(filepath.Clean(fn), []byte(CleanupScript(em)), 0o600); err != nil {
+		return fmt.Errorf("failed writing cleanup script to file: %w", err)
+	}
+
+	return nil
+}
+
+// shellQuote quotes a string as a single shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
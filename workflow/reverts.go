@@ -0,0 +1,116 @@
+package workflow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Git-Gopher/go-gopher/markup"
+	"github.com/Git-Gopher/go-gopher/model/enriched"
+)
+
+// revertReport summarises reverts, a signal of changes that failed after being merged.
+type revertReport struct {
+	Reverts              int        `json:"reverts"`
+	ByMessage            int        `json:"byMessage"`
+	ByDiff               int        `json:"byDiff"`
+	MedianTimeToRevert   string     `json:"medianTimeToRevert"`
+	RevertedPullRequests []int      `json:"revertedPullRequests"`
+	Chains               [][]string `json:"chains"` // Reverts of reverts, original commit first.
+}
+
+// Create a revert report from the reverts of the enriched model.
+func newRevertReport(reverts []enriched.Revert) revertReport {
+	report := revertReport{
+		Reverts:              len(reverts),
+		RevertedPullRequests: []int{},
+		Chains:               [][]string{},
+	}
+
+	durations := make([]time.Duration, 0, len(reverts))
+	prs := make(map[int]bool)
+
+	for _, r := range reverts {
+		switch r.Method {
+		case enriched.RevertMessage:
+			report.ByMessage++
+		case enriched.RevertDiff:
+			report.ByDiff++
+		}
+		durations = append(durations, r.TimeToRevert)
+
+		for _, pr := range r.PullRequests {
+			if !prs[pr.Number] {
+				prs[pr.Number] = true
+				report.RevertedPullRequests = append(report.RevertedPullRequests, pr.Number)
+			}
+		}
+	}
+	sort.Ints(report.RevertedPullRequests)
+
+	if len(durations) != 0 {
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		report.MedianTimeToRevert = durations[len(durations)/2].String()
+	}
+
+	for _, chain := range enriched.RevertChains(reverts) {
+		if len(chain.Reverts) < 2 {
+			continue
+		}
+
+		hashes := []string{chain.Original.HexString()}
+		for _, r := range chain.Reverts {
+			hashes = append(hashes, r.Commit.HexString())
+		}
+		report.Chains = append(report.Chains, hashes)
+	}
+
+	return report
+}
+
+// Print the reverts, time to revert, reverted pull requests and revert chains to stdout.
+func PrintReverts(em *enriched.EnrichedModel) {
+	reverts := em.FindReverts()
+	report := newRevertReport(reverts)
+
+	short := func(hash string) string {
+		return hash[:7]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("reverts: %d (message: %d, diff: %d)\n", report.Reverts, report.ByMessage, report.ByDiff))
+	if report.Reverts == 0 {
+		markup.Group("Reverts", sb.String())
+
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf("median time to revert: %s\n", report.MedianTimeToRevert))
+	for _, r := range reverts {
+		numbers := make([]string, len(r.PullRequests))
+		for i, pr := range r.PullRequests {
+			numbers[i] = fmt.Sprintf("#%d", pr.Number)
+		}
+
+		sb.WriteString(fmt.Sprintf("%s reverts %s after %s", short(r.Commit.HexString()),
+			short(r.Reverted.HexString()), r.TimeToRevert))
+		if len(numbers) != 0 {
+			sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(numbers, ", ")))
+		}
+		sb.WriteString("\n")
+	}
+
+	for _, chain := range report.Chains {
+		hashes := make([]string, len(chain))
+		for i, h := range chain {
+			hashes[i] = short(h)
+		}
+		state := "reverted"
+		if len(chain)%2 == 1 {
+			state = "reapplied"
+		}
+		sb.WriteString(fmt.Sprintf("chain: %s (%s)\n", strings.Join(hashes, " <- "), state))
+	}
+	markup.Group("Reverts", sb.String())
+}
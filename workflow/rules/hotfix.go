@@ -26,6 +26,10 @@ func NewHotfix(w *rule.Weights) *rule.Runner {
 
 				_, found, total, _ := d.Result()
 
+				// Merged hotfix pull requests are hotfixes that were not tagged.
+				found += d.PullRequests()
+				total += d.PullRequests()
+
 				score = float64(found) / float64(total)
 				if math.IsNaN(score) {
 					score = 0
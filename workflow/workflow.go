@@ -12,6 +12,7 @@ import (
 	"github.com/Git-Gopher/go-gopher/cache"
 	"github.com/Git-Gopher/go-gopher/config"
 	"github.com/Git-Gopher/go-gopher/detector"
+	"github.com/Git-Gopher/go-gopher/identity"
 	"github.com/Git-Gopher/go-gopher/markup"
 	"github.com/Git-Gopher/go-gopher/model/enriched"
 	"github.com/Git-Gopher/go-gopher/utils"
@@ -20,36 +21,81 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var (
+	DefaultCsvPath        = "summary.csv"
+	cacheDetectorRegistry = map[string]detector.CacheDetector{
+		"ForcePushDetect": detector.NewCommitCacheDetector(detector.ForcePushDetect()),
+	}
+	UnknownLogin = "unknown"
+)
+
 // XXX: This is a hack to get the name of the detector.
 // This should really be done using reflect so that you don't
 // have to think about changing this part of the code whenever you
 // change the name of a detector, making this rather brittle.
-var (
-	DefaultCsvPath   = "summary.csv"
-	detectorRegistry = map[string]detector.Detector{
-		"StaleBranchDetect":               detector.NewBranchDetector(detector.StaleBranchDetect()),
+func detectorRegistry(p config.Parameters) map[string]detector.Detector {
+	return map[string]detector.Detector{
+		"StaleBranchDetect":               detector.NewBranchDetector(detector.StaleBranchDetect(p.StaleBranchDetect)),
 		"PullRequestApprovalDetector":     detector.NewPullRequestDetector(detector.PullRequestApprovalDetector()),
 		"PullRequestIssueDetector":        detector.NewPullRequestDetector(detector.PullRequestIssueDetector()),
 		"PullRequestReviewThreadDetector": detector.NewPullRequestDetector(detector.PullRequestReviewThreadDetector()),
 		"DiffMatchesMessageDetect":        detector.NewCommitDetector(detector.DiffMatchesMessageDetect()),
-		"ShortCommitMessageDetect":        detector.NewCommitDetector(detector.ShortCommitMessageDetect()),
-		"DiffDistanceCalculation":         detector.NewCommitDistanceDetector(detector.DiffDistanceCalculation()),
-		"BranchNameConsistencyDetect":     detector.NewBranchCompareDetector(detector.BranchNameConsistencyDetect()),
-		"FeatureBranchDetector":           detector.NewFeatureBranchDetector("FeatureBranchDetector"),
-		"CrissCrossMergeDetect":           detector.NewBranchMatrixDetector(detector.CrissCrossMergeDetect()),
-		"UnresolvedDetect":                detector.NewCommitDetector(detector.UnresolvedDetect()),
-		"EmptyCommitDetect":               detector.NewCommitDetector(detector.EmptyCommitDetect()),
+		"ShortCommitMessageDetect": detector.NewCommitDetector(
+			detector.ShortCommitMessageDetect(p.ShortCommitMessageDetect),
+		),
+		"DiffDistanceCalculation": detector.NewCommitDistanceDetector(detector.DiffDistanceCalculation()),
+		"BranchNameConsistencyDetect": detector.NewBranchCompareDetector(
+			detector.BranchNameConsistencyDetect(p.BranchNameConsistencyDetect),
+		),
+		"FeatureBranchDetector": detector.NewFeatureBranchDetector("FeatureBranchDetector"),
+		"CrissCrossMergeDetect": detector.NewBranchMatrixDetector(detector.CrissCrossMergeDetect()),
+		"UnresolvedDetect":      detector.NewCommitDetector(detector.UnresolvedDetect()),
+		"EmptyCommitDetect":     detector.NewCommitDetector(detector.EmptyCommitDetect()),
+		"BinaryDetect":          detector.NewCommitDetector(detector.BinaryDetect(p.BinaryDetect)),
+		"ConventionalCommitDetect": detector.NewCommitDetector(
+			detector.ConventionalCommitDetect(p.ConventionalCommitDetect),
+		),
+		"CommitMessageLintDetect": detector.NewCommitDetector(
+			detector.CommitMessageLintDetect(p.CommitMessageLintDetect),
+		),
+		"SecretDetector":      detector.NewSecretDetector("SecretDetector", p.SecretDetector),
+		"LargeFileDetector":   detector.NewLargeFileDetector("LargeFileDetector", p.LargeFileDetector),
+		"FixupCommitDetector": detector.NewFixupCommitDetector("FixupCommitDetector", p.FixupCommitDetector),
+		"BranchDivergenceDetect": detector.NewBranchDetector(
+			detector.BranchDivergenceDetect(p.BranchDivergenceDetect),
+		),
+		"PullRequestSizeDetector": detector.NewPullRequestDetector(
+			detector.PullRequestSizeDetector(p.PullRequestSizeDetector),
+		),
+		"PullRequestRubberStampDetector": detector.NewPullRequestDetector(
+			detector.PullRequestRubberStampDetector(p.PullRequestRubberStampDetector, p.PullRequestSizeDetector.GeneratedPaths),
+		),
+		"PullRequestSelfMergeDetector":     detector.NewPullRequestDetector(detector.PullRequestSelfMergeDetector()),
+		"PullRequestStaleApprovalDetector": detector.NewPullRequestDetector(detector.PullRequestStaleApprovalDetector()),
+		"PullRequestDescriptionDetector":   detector.NewPullRequestDetector(detector.PullRequestDescriptionDetector()),
+		"CommitTimestampDetector": detector.NewCommitTimestampDetector(
+			"CommitTimestampDetector", p.CommitTimestampDetector, time.Time{},
+		),
+		"BranchNamingDetect":             detector.NewBranchDetector(detector.BranchNamingDetect(p.BranchNamingDetect)),
+		"MergedBranchDetect":             detector.NewBranchDetector(detector.MergedBranchDetect()),
+		"IssueClosedWithoutLinkDetector": detector.NewIssueDetector(detector.IssueClosedWithoutLinkDetector()),
+		"StaleIssueDetector":             detector.NewIssueDetector(detector.StaleIssueDetector(p.StaleIssueDetector)),
+		"IssueTriageDetector":            detector.NewIssueDetector(detector.IssueTriageDetector()),
+		"CommitIssueReferenceDetect":     detector.NewCommitDetector(detector.CommitIssueReferenceDetect()),
+		"FormattingCommitDetect":         detector.NewCommitDetector(detector.FormattingCommitDetect()),
+		"EvilMergeDetect":                detector.NewCommitDetector(detector.EvilMergeDetect()),
+		"BuildArtifactDetector": detector.NewBuildArtifactDetector(
+			"BuildArtifactDetector", p.BuildArtifactDetector,
+		),
+		"TestAccompanimentDetector": detector.NewTestAccompanimentDetector(
+			"TestAccompanimentDetector", p.TestAccompanimentDetector, p.PullRequestSizeDetector.GeneratedPaths,
+		),
 
 		// Disabled
 		// "NewFeatureBranchNameDetect": detector.NewBranchCompareDetector(detector.NewFeatureBranchNameDetect()),
 		// "TwoParentsCommitDetect":     detector.NewCommitDetector(detector.TwoParentsCommitDetect()),
-		// "BinaryDetect":                    detector.NewCommitDetector(detector.BinaryDetect()),
-	}
-	cacheDetectorRegistry = map[string]detector.CacheDetector{
-		"ForcePushDetect": detector.NewCommitCacheDetector(detector.ForcePushDetect()),
 	}
-	UnknownLogin = "unknown"
-)
+}
 
 type Workflow struct {
 	Name                    string                  `json:"name"`
@@ -83,16 +129,29 @@ func GithubFlowWorkflow(cfg *config.Config) *Workflow {
 // TODO: Remove this & use the config file instead. But it's currently useful for testing probably.
 // LocalDetectors are detectors that can run locally without GitHub API calls.
 func LocalDetectors() []detector.Detector {
+	p := config.DefaultParameters()
+
 	return []detector.Detector{
-		detector.NewBranchDetector(detector.StaleBranchDetect()),
+		detector.NewBranchDetector(detector.StaleBranchDetect(p.StaleBranchDetect)),
 		detector.NewCommitDetector(detector.DiffMatchesMessageDetect()),
-		detector.NewCommitDetector(detector.ShortCommitMessageDetect()),
+		detector.NewCommitDetector(detector.ShortCommitMessageDetect(p.ShortCommitMessageDetect)),
 		detector.NewCommitDetector(detector.UnresolvedDetect()),
 		detector.NewCommitDistanceDetector(detector.DiffDistanceCalculation()),
-		detector.NewBranchCompareDetector(detector.BranchNameConsistencyDetect()),
+		detector.NewBranchCompareDetector(detector.BranchNameConsistencyDetect(p.BranchNameConsistencyDetect)),
 		detector.NewCommitDetector(detector.BranchCommitDetect()), // used to check if branches are used
 		detector.NewFeatureBranchDetector("FeatureBranchDetector"),
 		detector.NewBranchMatrixDetector(detector.CrissCrossMergeDetect()),
+		detector.NewSecretDetector("SecretDetector", p.SecretDetector),
+		detector.NewLargeFileDetector("LargeFileDetector", p.LargeFileDetector),
+		detector.NewFixupCommitDetector("FixupCommitDetector", p.FixupCommitDetector),
+		detector.NewBranchDetector(detector.BranchDivergenceDetect(p.BranchDivergenceDetect)),
+		detector.NewCommitTimestampDetector("CommitTimestampDetector", p.CommitTimestampDetector, time.Time{}),
+		detector.NewBranchDetector(detector.MergedBranchDetect()),
+		detector.NewBuildArtifactDetector("BuildArtifactDetector", p.BuildArtifactDetector),
+		detector.NewCommitDetector(detector.EvilMergeDetect()),
+		detector.NewTestAccompanimentDetector(
+			"TestAccompanimentDetector", p.TestAccompanimentDetector, p.PullRequestSizeDetector.GeneratedPaths,
+		),
 	}
 }
 
@@ -148,6 +207,8 @@ func (w *Workflow) RunWeightedDetectors(model *enriched.EnrichedModel) (
 	violations []violation.Violation,
 	err error,
 ) {
+	defer detector.ReleaseCommon(model)
+
 	for _, wd := range w.WeightedCommitDetectors {
 		if err := wd.Detector.Run(model); err != nil {
 			return 0, 0, 0, nil, fmt.Errorf("Failed to run weighted detectors: %w", err)
@@ -271,10 +332,17 @@ func configureDetectors(cfg *config.Config) ([]WeightedDetector, []WeightedCache
 	var weightedCommitDetectors []WeightedDetector
 	var weightedCacheDetectors []WeightedCacheDetector
 
+	registry := detectorRegistry(cfg.Parameters)
 	for k := range cfg.Detectors {
 		// Check keys match between config and registry.
 		found := false
-		if val, ok := detectorRegistry[k]; ok {
+		if val, ok := registry[k]; ok {
+			if cd, ok := val.(detector.ConfigurableDetector); ok {
+				if err := cd.Configure(cfg); err != nil {
+					log.Printf("Could not configure detector \"%s\": %v", k, err)
+				}
+			}
+
 			weightedCommitDetectors = append(weightedCommitDetectors, WeightedDetector{
 				Detector: val,
 				Weight:   cfg.Detectors[k].Weight,
@@ -317,12 +385,16 @@ func (w *Workflow) WriteLog(em enriched.EnrichedModel, cfg *config.Config) (stri
 	}
 
 	type log struct {
-		Name       string         `json:"name"`
-		URL        string         `json:"url"`
-		Date       time.Time      `json:"date"`
-		Violations []logViolation `json:"violations"`
-		Workflow   Workflow       `json:"workflow"`
-		Config     config.Config  `json:"config"`
+		Name            string                `json:"name"`
+		URL             string                `json:"url"`
+		Date            time.Time             `json:"date"`
+		Violations      []logViolation        `json:"violations"`
+		Workflow        Workflow              `json:"workflow"`
+		Config          config.Config         `json:"config"`
+		MergeStrategies map[string]int        `json:"mergeStrategies"`
+		MergeDirections map[string]int        `json:"mergeDirections"`
+		BranchRoles     *enriched.BranchRoles `json:"branchRoles"`
+		Reverts         revertReport          `json:"reverts"`
 	}
 
 	LogViolations := make([]logViolation, len(w.Violations))
@@ -358,12 +430,16 @@ func (w *Workflow) WriteLog(em enriched.EnrichedModel, cfg *config.Config) (stri
 	}
 
 	l := log{
-		Name:       em.Name,
-		URL:        em.URL,
-		Date:       time.Now(),
-		Violations: LogViolations,
-		Workflow:   *w,
-		Config:     *cfg,
+		Name:            em.Name,
+		URL:             em.URL,
+		Date:            time.Now(),
+		Violations:      LogViolations,
+		Workflow:        *w,
+		Config:          *cfg,
+		MergeStrategies: mergeStrategyCounts(&em),
+		MergeDirections: mergeDirectionCounts(&em),
+		BranchRoles:     em.Roles,
+		Reverts:         newRevertReport(em.FindReverts()),
 	}
 
 	bytes, err := json.MarshalIndent(l, "", " ")
@@ -380,7 +456,7 @@ func (w *Workflow) WriteLog(em enriched.EnrichedModel, cfg *config.Config) (stri
 }
 
 // Print a summary of the workflow violations to stdout.
-func PrintSummary(authors utils.Authors, v, c, t int, vs []violation.Violation) {
+func PrintSummary(identities identity.Resolver, v, c, t int, vs []violation.Violation) {
 	var violations, suggestions []violation.Violation
 	for _, v := range vs {
 		switch v.Severity() {
@@ -393,30 +469,21 @@ func PrintSummary(authors utils.Authors, v, c, t int, vs []violation.Violation)
 
 	var vSd strings.Builder
 	for _, v := range violations {
-		vSd.WriteString(v.Display(authors))
+		vSd.WriteString(v.Display(identities))
 	}
 	markup.Group("Violations", vSd.String())
 
 	var sSd strings.Builder
 	for _, v := range suggestions {
-		sSd.WriteString(v.Display(authors))
+		sSd.WriteString(v.Display(identities))
 	}
 	markup.Group("Suggestions", sSd.String())
 
 	var aSd strings.Builder
 	counts := make(map[string]int)
 	for _, v := range vs {
-		var login string
-
-		if l, err := v.Login(); err == nil {
-			login = l
-		} else if email, err := v.Email(); err == nil {
-			l, err := authors.FindUserName(email)
-			if err != nil {
-				continue
-			}
-			login = *l
-		} else {
+		login, err := violation.ResolveLogin(v, identities)
+		if err != nil {
 			continue
 		}
 		counts[login]++
@@ -432,9 +499,89 @@ func PrintSummary(authors utils.Authors, v, c, t int, vs []violation.Violation)
 	markup.Group("Summary", aSd.String())
 }
 
+// Print the distribution of merge strategies used by merged pull requests to stdout.
+func PrintMergeStrategies(em *enriched.EnrichedModel) {
+	counts := mergeStrategyCounts(em)
+
+	var sb strings.Builder
+	for _, s := range []enriched.MergeStrategy{
+		enriched.MergeCommitStrategy,
+		enriched.SquashStrategy,
+		enriched.RebaseStrategy,
+		enriched.UnknownStrategy,
+	} {
+		sb.WriteString(fmt.Sprintf("%s: %d\n", s, counts[s.String()]))
+	}
+	markup.Group("Merge Strategies", sb.String())
+}
+
+// Print the number of merge commits in each direction to stdout.
+func PrintMergeDirections(em *enriched.EnrichedModel) {
+	counts := mergeDirectionCounts(em)
+
+	var sb strings.Builder
+	for _, d := range []enriched.MergeDirection{
+		enriched.ForwardMerge,
+		enriched.BackMerge,
+		enriched.CrossMerge,
+		enriched.LongLivedMerge,
+		enriched.UnknownDirection,
+	} {
+		sb.WriteString(fmt.Sprintf("%s: %d\n", d, counts[d.String()]))
+	}
+	markup.Group("Merge Directions", sb.String())
+}
+
+// Print the configured and inferred branch roles to stdout.
+func PrintBranchRoles(em *enriched.EnrichedModel) {
+	roles := em.Roles
+	if roles == nil {
+		return
+	}
+
+	source := func(r enriched.BranchRole) string {
+		if roles.Inferred[r] {
+			return "inferred"
+		}
+
+		return "configured"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s: %s (%s)\n", enriched.PrimaryRole, roles.Primary, source(enriched.PrimaryRole)))
+	sb.WriteString(fmt.Sprintf("%s: %s (%s)\n", enriched.DevelopRole, roles.Develop, source(enriched.DevelopRole)))
+	sb.WriteString(fmt.Sprintf("%s: %s (%s)\n", enriched.ReleaseRole,
+		strings.Join(roles.Release, ", "), source(enriched.ReleaseRole)))
+	sb.WriteString(fmt.Sprintf("%s: %s (%s)\n", enriched.EnvironmentRole,
+		strings.Join(roles.Environment, ", "), source(enriched.EnvironmentRole)))
+	sb.WriteString(fmt.Sprintf("%s: %s (%s)\n", enriched.HotfixRole,
+		strings.Join(roles.Hotfix, ", "), source(enriched.HotfixRole)))
+	markup.Group("Branch Roles", sb.String())
+}
+
+// Count merged pull requests by merge strategy name.
+func mergeStrategyCounts(em *enriched.EnrichedModel) map[string]int {
+	counts := make(map[string]int)
+	for s, count := range em.MergeStrategyDistribution() {
+		counts[s.String()] = count
+	}
+
+	return counts
+}
+
+// Count merge commits by merge direction name.
+func mergeDirectionCounts(em *enriched.EnrichedModel) map[string]int {
+	counts := make(map[string]int)
+	for d, count := range em.MergeDirectionDistribution() {
+		counts[d.String()] = count
+	}
+
+	return counts
+}
+
 // Create a markdown summary for a workflow, inluding a summary of the violations and suggestions.
 // Usually used in pull request comments.
-func MarkdownSummary(authors utils.Authors, vs []violation.Violation) string { // nolint: gocognit
+func MarkdownSummary(identities identity.Resolver, vs []violation.Violation) string { // nolint: gocognit
 	md := markup.CreateMarkdown("Workflow Summary")
 	md.AddLine(fmt.Sprintf("Created with git-gopher version `%s`", version.BuildVersion()))
 
@@ -458,8 +605,8 @@ func MarkdownSummary(authors utils.Authors, vs []violation.Violation) string { /
 	}
 
 	// Create table.
-	markdownTable(md, violations, "Violation", authors)
-	markdownTable(md, suggestions, "Suggestion", authors)
+	markdownTable(md, violations, "Violation", identities)
+	markdownTable(md, suggestions, "Suggestion", identities)
 
 	workflowUrl := os.Getenv("WORKFLOW_URL")
 	if (len(violations)+len(suggestions)) < len(vs) && workflowUrl != "" {
@@ -474,7 +621,7 @@ func MarkdownSummary(authors utils.Authors, vs []violation.Violation) string { /
 }
 
 // Create a markdown table for a array of violations.
-func markdownTable(md *markup.Markdown, violations []violation.Violation, tableHeader string, authors utils.Authors) {
+func markdownTable(md *markup.Markdown, violations []violation.Violation, tableHeader string, identities identity.Resolver) {
 	if len(violations) > 0 { // nolint: nestif
 		headers := []string{tableHeader, "Message", "Advice", "Author"}
 		rows := make([][]string, len(violations))
@@ -492,17 +639,8 @@ func markdownTable(md *markup.Markdown, violations []violation.Violation, tableH
 			}
 			row[2] = suggestion
 
-			var login string
-			if l, err := v.Login(); err == nil {
-				login = l
-			} else if email, err := v.Email(); err == nil {
-				l, err := authors.FindUserName(email)
-				if err != nil {
-					login = UnknownLogin
-				} else {
-					login = *l
-				}
-			} else {
+			login, err := violation.ResolveLogin(v, identities)
+			if err != nil {
 				login = UnknownLogin
 			}
 